@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// resourceCap returns the maximum number of resources a single resolve of a table may return, 0 means unlimited.
+func (c *Client) resourceCap(relation bool) int {
+	if relation {
+		return c.maxResourcesPerRelation
+	}
+	return c.maxResourcesPerTable
+}
+
+// WithResourceCaps wraps the resolvers of a table and its relations so they stop once max_resources_per_table
+// resources, or max_resources_per_relation resources of each parent, were fetched. The resolver's context is
+// cancelled when the cap is reached, which stops its pagination early.
+func WithResourceCaps(t *schema.Table) *schema.Table {
+	return withResourceCaps(t, false)
+}
+
+func withResourceCaps(t *schema.Table, relation bool) *schema.Table {
+	resolver := t.Resolver
+	t.Resolver = func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+		limit := meta.(*Client).resourceCap(relation)
+		if limit <= 0 {
+			return resolver(ctx, meta, parent, res)
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		items := make(chan interface{})
+		errc := make(chan error, 1)
+		go func() {
+			defer close(items)
+			errc <- resolver(ctx, meta, parent, items)
+		}()
+
+		sent := 0
+		for elem := range items {
+			// keep draining once the cap is reached so the resolver isn't blocked on sending
+			if sent >= limit {
+				continue
+			}
+			if v := reflect.ValueOf(elem); v.Kind() == reflect.Slice {
+				if sent+v.Len() > limit {
+					elem = v.Slice(0, limit-sent).Interface()
+					sent = limit
+				} else {
+					sent += v.Len()
+				}
+			} else {
+				sent++
+			}
+			res <- elem
+			if sent >= limit {
+				meta.Logger().Debug("resource cap reached", "table", t.Name, "cap", limit)
+				cancel()
+			}
+		}
+		err := <-errc
+		if sent >= limit {
+			// errors after the cap was reached are caused by the cancelled context
+			return nil
+		}
+		return err
+	}
+	for _, rel := range t.Relations {
+		withResourceCaps(rel, true)
+	}
+	return t
+}
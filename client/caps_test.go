@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+// pagedResolver sends pages of two items until its context is cancelled, returning the number of pages sent
+func pagedResolver(pages *int) schema.TableResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+		for i := 0; i < 100; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			*pages++
+			res <- []string{"a", "b"}
+		}
+		return nil
+	}
+}
+
+func TestResourceCaps(t *testing.T) {
+	var pages, relationPages int
+	table := WithResourceCaps(&schema.Table{
+		Name:      "aws_test",
+		Resolver:  pagedResolver(&pages),
+		Relations: []*schema.Table{{Name: "aws_test_relation", Resolver: pagedResolver(&relationPages)}},
+	})
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.maxResourcesPerTable = 3
+	c.maxResourcesPerRelation = 1
+
+	for _, tc := range []struct {
+		table    *schema.Table
+		pages    *int
+		expected int
+	}{
+		{table, &pages, 3},
+		{table.Relations[0], &relationPages, 1},
+	} {
+		res := make(chan interface{})
+		errc := make(chan error, 1)
+		go func() {
+			defer close(res)
+			errc <- tc.table.Resolver(context.Background(), &c, nil, res)
+		}()
+		count := 0
+		for elem := range res {
+			count += len(elem.([]string))
+		}
+		if err := <-errc; err != nil {
+			t.Fatal(err)
+		}
+		if count != tc.expected {
+			t.Fatalf("expected %d resources of %s got %d", tc.expected, tc.table.Name, count)
+		}
+		// the resolver stops paginating soon after the cap is reached
+		if *tc.pages > tc.expected+1 {
+			t.Fatalf("expected %s to stop paginating got %d pages", tc.table.Name, *tc.pages)
+		}
+	}
+}
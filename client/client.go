@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
@@ -78,6 +79,9 @@ var allRegions = []string{
 
 const defaultRegion = "us-east-1"
 
+// GlobalRegion is the region of clients returned by GlobalMultiplex, their services are keyed by it
+const GlobalRegion = "aws-global"
+
 type Services struct {
 	Analyzer         AnalyzerClient
 	Autoscaling      AutoscalingClient
@@ -122,6 +126,13 @@ func (s *ServicesManager) ServicesByAccountAndRegion(accountId string, region st
 	if region == "" {
 		region = defaultRegion
 	}
+	if region == GlobalRegion {
+		if services, ok := s.services[accountId][GlobalRegion]; ok {
+			return services
+		}
+		// services initialized without a global entry, as in tests, serve global tables from the default region
+		region = defaultRegion
+	}
 	return s.services[accountId][region]
 }
 
@@ -135,12 +146,23 @@ func (s *ServicesManager) InitServicesForAccountAndRegion(accountId string, regi
 type Client struct {
 	// Those are already normalized values after configure and this is why we don't want to hold
 	// config directly.
-	regions         []string
-	logLevel        *string
-	maxRetries      int
-	maxBackoff      int
-	ServicesManager ServicesManager
-	logger          hclog.Logger
+	regions                 []string
+	logLevel                *string
+	maxRetries              int
+	maxBackoff              int
+	ServicesManager         ServicesManager
+	logger                  hclog.Logger
+	terraformState          *TerraformState
+	targets                 *Targets
+	secretScanner           *SecretScanner
+	redactSecrets           bool
+	columnPolicies          *ColumnPolicies
+	maxResourcesPerTable    int
+	maxResourcesPerRelation int
+	instanceImages          bool
+	instanceAttributes      *Limiter
+	hashUserData            bool
+	memo                    *memo
 
 	// this is set by table clientList
 	AccountID string
@@ -175,6 +197,7 @@ func NewAwsClient(logger hclog.Logger, regions []string) Client {
 		},
 		logger:  logger,
 		regions: regions,
+		memo:    newMemo(),
 	}
 }
 
@@ -186,29 +209,120 @@ func (c *Client) Services() *Services {
 	return c.ServicesManager.ServicesByAccountAndRegion(c.AccountID, c.Region)
 }
 
+// Regions returns the enabled regions the client was configured to fetch from.
+func (c *Client) Regions() []string {
+	return c.regions
+}
+
+// InstanceImages reports whether images of other owners used by instances should be fetched with the account's images
+func (c *Client) InstanceImages() bool {
+	return c.instanceImages
+}
+
+// InstanceAttributes returns the limiter of the per instance attribute calls, or nil if instance attributes
+// shouldn't be fetched
+func (c *Client) InstanceAttributes() *Limiter {
+	return c.instanceAttributes
+}
+
+// HashUserData reports whether only the hash and size of instance user data should be stored
+func (c *Client) HashUserData() bool {
+	return c.hashUserData
+}
+
+// ColumnPolicies returns the configured redact and max_column_bytes policies, nil when there are none
+func (c *Client) ColumnPolicies() *ColumnPolicies {
+	return c.columnPolicies
+}
+
+// SetColumnPolicies sets the redact and max_column_bytes policies applied to the rows of the client's tables
+func (c *Client) SetColumnPolicies(policies *ColumnPolicies) {
+	c.columnPolicies = policies
+}
+
+// Partition returns the partition of the client's region, or of the configured regions for clients of global
+// services, i.e aws-us-gov for GovCloud accounts
+func (c *Client) Partition() string {
+	region := c.Region
+	if (region == "" || region == GlobalRegion) && len(c.regions) > 0 {
+		region = c.regions[0]
+	}
+	return RegionPartition(region)
+}
+
+// TerraformState returns the parsed terraform_state_paths, or nil if none were configured.
+func (c *Client) TerraformState() *TerraformState {
+	return c.terraformState
+}
+
+// WithRegion returns a copy of the client bound to the same account and the given region.
+func (c *Client) WithRegion(region string) *Client {
+	return c.withAccountIDAndRegion(c.AccountID, region)
+}
+
+// Accounts returns the ids of the configured accounts included by the targets, sorted.
+func (c *Client) Accounts() []string {
+	accounts := make([]string, 0, len(c.ServicesManager.services))
+	for accountID := range c.ServicesManager.services {
+		if c.targets != nil && !c.targets.matchesAccount(accountID) {
+			continue
+		}
+		accounts = append(accounts, accountID)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+// WithAccountIDAndRegion returns a copy of the client bound to the given account and region.
+func (c *Client) WithAccountIDAndRegion(accountID string, region string) *Client {
+	return c.withAccountIDAndRegion(accountID, region)
+}
+
 func (c *Client) withAccountID(accountID string) *Client {
 	return &Client{
-		regions:         c.regions,
-		logLevel:        c.logLevel,
-		maxRetries:      c.maxRetries,
-		maxBackoff:      c.maxBackoff,
-		ServicesManager: c.ServicesManager,
-		logger:          c.logger.With("account_id", accountID),
-		AccountID:       accountID,
-		Region:          c.Region,
+		regions:                 c.regions,
+		logLevel:                c.logLevel,
+		maxRetries:              c.maxRetries,
+		maxBackoff:              c.maxBackoff,
+		ServicesManager:         c.ServicesManager,
+		terraformState:          c.terraformState,
+		targets:                 c.targets,
+		secretScanner:           c.secretScanner,
+		redactSecrets:           c.redactSecrets,
+		columnPolicies:          c.columnPolicies,
+		maxResourcesPerTable:    c.maxResourcesPerTable,
+		maxResourcesPerRelation: c.maxResourcesPerRelation,
+		instanceImages:          c.instanceImages,
+		instanceAttributes:      c.instanceAttributes,
+		hashUserData:            c.hashUserData,
+		memo:                    c.memo,
+		logger:                  c.logger.With("account_id", accountID),
+		AccountID:               accountID,
+		Region:                  c.Region,
 	}
 }
 
 func (c *Client) withAccountIDAndRegion(accountID string, region string) *Client {
 	return &Client{
-		regions:         c.regions,
-		logLevel:        c.logLevel,
-		maxRetries:      c.maxRetries,
-		maxBackoff:      c.maxBackoff,
-		ServicesManager: c.ServicesManager,
-		logger:          c.logger.With("account_id", accountID, "Region", region),
-		AccountID:       accountID,
-		Region:          region,
+		regions:                 c.regions,
+		logLevel:                c.logLevel,
+		maxRetries:              c.maxRetries,
+		maxBackoff:              c.maxBackoff,
+		ServicesManager:         c.ServicesManager,
+		terraformState:          c.terraformState,
+		targets:                 c.targets,
+		secretScanner:           c.secretScanner,
+		redactSecrets:           c.redactSecrets,
+		columnPolicies:          c.columnPolicies,
+		maxResourcesPerTable:    c.maxResourcesPerTable,
+		maxResourcesPerRelation: c.maxResourcesPerRelation,
+		instanceImages:          c.instanceImages,
+		instanceAttributes:      c.instanceAttributes,
+		hashUserData:            c.hashUserData,
+		memo:                    c.memo,
+		logger:                  c.logger.With("account_id", accountID, "Region", region),
+		AccountID:               accountID,
+		Region:                  region,
 	}
 }
 
@@ -222,12 +336,53 @@ func Configure(logger hclog.Logger, providerConfig interface{}) (schema.ClientMe
 		logger.Info(fmt.Sprintf("No regions specified in config.yml. Assuming all %d regions", len(client.regions)))
 	}
 
+	if len(awsConfig.TerraformStatePaths) > 0 {
+		state, err := LoadTerraformState(awsConfig.TerraformStatePaths)
+		if err != nil {
+			return nil, err
+		}
+		client.terraformState = state
+	}
+
+	if len(awsConfig.TargetARNs) > 0 {
+		targets, err := ParseTargets(awsConfig.TargetARNs)
+		if err != nil {
+			return nil, err
+		}
+		client.targets = targets
+	}
+
+	client.secretScanner = NewSecretScanner(DefaultSecretRules)
+	client.redactSecrets = awsConfig.RedactSecrets
+
+	if awsConfig.RegionsSample > 0 && awsConfig.RegionsSample < len(client.regions) {
+		client.regions = client.regions[:awsConfig.RegionsSample]
+	}
+	client.maxResourcesPerTable = awsConfig.MaxResourcesPerTable
+	client.maxResourcesPerRelation = awsConfig.MaxResourcesPerRelation
+	client.instanceImages = awsConfig.InstanceImages
+	if awsConfig.InstanceAttributes {
+		client.instanceAttributes = NewLimiter(awsConfig.InstanceAttributesConcurrency)
+	}
+	client.hashUserData = awsConfig.HashUserData
+
+	if len(awsConfig.Redact) > 0 || len(awsConfig.MaxColumnBytes) > 0 {
+		policies, err := NewColumnPolicies(awsConfig.Redact, awsConfig.MaxColumnBytes)
+		if err != nil {
+			return nil, err
+		}
+		client.columnPolicies = policies
+	}
+
 	if len(awsConfig.Accounts) == 0 {
 		awsConfig.Accounts = append(awsConfig.Accounts, Account{
 			ID:      "default",
 			RoleARN: "default",
 		})
 	}
+	if awsConfig.AccountsSample > 0 && awsConfig.AccountsSample < len(awsConfig.Accounts) {
+		awsConfig.Accounts = awsConfig.Accounts[:awsConfig.AccountsSample]
+	}
 
 	for _, account := range awsConfig.Accounts {
 		var err error
@@ -295,11 +450,20 @@ func Configure(logger hclog.Logger, providerConfig interface{}) (schema.ClientMe
 		for _, region := range client.regions {
 			client.ServicesManager.InitServicesForAccountAndRegion(*output.Account, region, initServices(awsCfg))
 		}
+		// global services are pinned to the global endpoint of the partition, instead of the first configured region
+		globalCfg := awsCfg.Copy()
+		globalCfg.Region = partitionGlobalRegion(client.regions[0])
+		client.ServicesManager.InitServicesForAccountAndRegion(*output.Account, GlobalRegion, initServices(globalCfg))
 	}
 
 	return &client, nil
 }
 
+// partitionGlobalRegion returns the endpoint region of global services in the partition of region
+func partitionGlobalRegion(region string) string {
+	return RegionPartition(region) + "-global"
+}
+
 func initServices(awsCfg aws.Config) Services {
 	return Services{
 		Autoscaling:      autoscaling.NewFromConfig(awsCfg),
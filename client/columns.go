@@ -0,0 +1,210 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const (
+	RedactHash     = "hash"
+	RedactTruncate = "truncate"
+	RedactDrop     = "drop"
+
+	// defaultRedactTruncateBytes is used by the truncate action of columns without a max_column_bytes limit
+	defaultRedactTruncateBytes = 1024
+)
+
+// ColumnPolicies holds the redact and max_column_bytes configuration, both keyed by <table>.<column>
+type ColumnPolicies struct {
+	redact         map[string]string
+	maxColumnBytes map[string]int
+}
+
+// NewColumnPolicies validates the redact and max_column_bytes configuration
+func NewColumnPolicies(redact map[string]string, maxColumnBytes map[string]int) (*ColumnPolicies, error) {
+	for column, action := range redact {
+		switch action {
+		case RedactHash, RedactTruncate, RedactDrop:
+		default:
+			return nil, fmt.Errorf("invalid redact action %q of %s, expected one of hash, truncate or drop", action, column)
+		}
+	}
+	for column, limit := range maxColumnBytes {
+		if limit <= 0 {
+			return nil, fmt.Errorf("invalid max_column_bytes %d of %s", limit, column)
+		}
+	}
+	return &ColumnPolicies{redact: redact, maxColumnBytes: maxColumnBytes}, nil
+}
+
+// ColumnPolicy is the redact action and max_column_bytes limit configured for a column
+type ColumnPolicy struct {
+	Table  string
+	Column string
+	// Action is empty for columns that only have a max_column_bytes limit
+	Action         string
+	MaxColumnBytes *int
+}
+
+// List returns the configured policies sorted by table and column
+func (p *ColumnPolicies) List() []ColumnPolicy {
+	if p == nil {
+		return nil
+	}
+	byKey := make(map[string]*ColumnPolicy)
+	policy := func(key string) *ColumnPolicy {
+		if cp, ok := byKey[key]; ok {
+			return cp
+		}
+		cp := &ColumnPolicy{}
+		if i := strings.LastIndex(key, "."); i >= 0 {
+			cp.Table, cp.Column = key[:i], key[i+1:]
+		} else {
+			cp.Column = key
+		}
+		byKey[key] = cp
+		return cp
+	}
+	for key, action := range p.redact {
+		policy(key).Action = action
+	}
+	for key, limit := range p.maxColumnBytes {
+		limit := limit
+		policy(key).MaxColumnBytes = &limit
+	}
+	policies := make([]ColumnPolicy, 0, len(byKey))
+	for _, cp := range byKey {
+		policies = append(policies, *cp)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Table != policies[j].Table {
+			return policies[i].Table < policies[j].Table
+		}
+		return policies[i].Column < policies[j].Column
+	})
+	return policies
+}
+
+// apply rewrites the configured columns of a resource
+func (p *ColumnPolicies) apply(t *schema.Table, resource *schema.Resource) error {
+	if p == nil {
+		return nil
+	}
+	for _, col := range t.Columns {
+		key := t.Name + "." + col.Name
+		action, redact := p.redact[key]
+		limit, limited := p.maxColumnBytes[key]
+		if !redact && !limited {
+			continue
+		}
+		v := resource.Get(col.Name)
+		data, ok := columnBytes(v)
+		if !ok {
+			continue
+		}
+		if !redact {
+			if len(data) <= limit {
+				continue
+			}
+			action = RedactTruncate
+		}
+		if !limited {
+			limit = defaultRedactTruncateBytes
+		}
+		value, changed := redactColumnValue(col, action, data, limit)
+		if !changed {
+			continue
+		}
+		if err := resource.Set(col.Name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// columnBytes returns the stored representation of a column value, ok is false for null values
+func columnBytes(v interface{}) ([]byte, bool) {
+	switch val := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return []byte(val), true
+	case *string:
+		if val == nil {
+			return nil, false
+		}
+		return []byte(*val), true
+	case []byte:
+		return val, true
+	}
+	data, err := json.Marshal(v)
+	if err != nil || string(data) == "null" {
+		return nil, false
+	}
+	return data, true
+}
+
+func redactColumnValue(col schema.Column, action string, data []byte, limit int) (interface{}, bool) {
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	switch action {
+	case RedactDrop:
+		return nil, true
+	case RedactHash:
+		switch col.Type {
+		case schema.TypeString:
+			return "sha256:" + hash, true
+		case schema.TypeJSON:
+			return map[string]interface{}{"sha256": hash}, true
+		case schema.TypeByteArray:
+			return []byte(hash), true
+		}
+		// other column types can't hold a hash, so the value is dropped
+		return nil, true
+	case RedactTruncate:
+		if len(data) <= limit {
+			return nil, false
+		}
+		switch col.Type {
+		case schema.TypeString:
+			cut := limit
+			for cut > 0 && !utf8.RuneStart(data[cut]) {
+				cut--
+			}
+			return string(data[:cut]), true
+		case schema.TypeJSON:
+			// truncated json isn't valid json, so only its size and hash are kept
+			return map[string]interface{}{"truncated": true, "original_bytes": len(data), "sha256": hash}, true
+		case schema.TypeByteArray:
+			return data[:limit], true
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// WithColumnPolicies applies the configured redact and max_column_bytes policies to the rows of a table and its
+// relations after each row is resolved. The policies themselves are listed in aws_redacted_columns.
+func WithColumnPolicies(t *schema.Table) *schema.Table {
+	postResolver := t.PostResourceResolver
+	t.PostResourceResolver = func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
+		if postResolver != nil {
+			if err := postResolver(ctx, meta, resource); err != nil {
+				return err
+			}
+		}
+		return meta.(*Client).columnPolicies.apply(t, resource)
+	}
+	for _, rel := range t.Relations {
+		WithColumnPolicies(rel)
+	}
+	return t
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestColumnPolicies(t *testing.T) {
+	table := WithColumnPolicies(&schema.Table{
+		Name: "aws_test",
+		Columns: []schema.Column{
+			{Name: "certificate", Type: schema.TypeString},
+			{Name: "document", Type: schema.TypeJSON},
+			{Name: "description", Type: schema.TypeString},
+			{Name: "name", Type: schema.TypeString},
+		},
+	})
+	if _, err := NewColumnPolicies(map[string]string{"aws_test.name": "encrypt"}, nil); err == nil {
+		t.Fatal("expected an invalid redact action error")
+	}
+	policies, err := NewColumnPolicies(
+		map[string]string{"aws_test.certificate": RedactHash, "aws_test.name": RedactDrop},
+		map[string]int{"aws_test.document": 16, "aws_test.description": 2},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.columnPolicies = policies
+
+	resource := schema.NewResourceData(table, nil, nil)
+	for k, v := range map[string]interface{}{
+		"certificate": "-----BEGIN CERTIFICATE-----",
+		"document":    map[string]interface{}{"Statement": []string{"a long policy statement"}},
+		"description": "héllo",
+		"name":        "name",
+	} {
+		if err := resource.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := table.PostResourceResolver(context.Background(), &c, resource); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := resource.Get("certificate").(string); !strings.HasPrefix(v, "sha256:") {
+		t.Fatalf("expected hashed certificate got %s", v)
+	}
+	if v := resource.Get("document").(map[string]interface{}); v["truncated"] != true {
+		t.Fatalf("expected truncated document got %v", v)
+	}
+	// truncation never splits a multi byte character
+	if v := resource.Get("description"); v != "h" {
+		t.Fatalf("expected truncated description got %v", v)
+	}
+	if v := resource.Get("name"); v != nil {
+		t.Fatalf("expected dropped name got %v", v)
+	}
+	// the table keeps its own columns, the policies are listed in aws_redacted_columns
+	if len(table.Columns) != 4 {
+		t.Fatalf("expected the table columns to be unchanged, got %+v", table.Columns)
+	}
+	sixteen, two := 16, 2
+	expected := []ColumnPolicy{
+		{Table: "aws_test", Column: "certificate", Action: RedactHash},
+		{Table: "aws_test", Column: "description", MaxColumnBytes: &two},
+		{Table: "aws_test", Column: "document", MaxColumnBytes: &sixteen},
+		{Table: "aws_test", Column: "name", Action: RedactDrop},
+	}
+	if v := c.ColumnPolicies().List(); !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected column policies %+v", v)
+	}
+}
@@ -11,6 +11,25 @@ type Config struct {
 	AWSDebug   bool      `hcl:"aws_debug,optional"`
 	MaxRetries int       `hcl:"max_retries,optional" default:"5"`
 	MaxBackoff int       `hcl:"max_backoff,optional" default:"30"`
+
+	TerraformStatePaths []string `hcl:"terraform_state_paths,optional"`
+	TargetARNs          []string `hcl:"target_arns,optional"`
+
+	RedactSecrets bool `hcl:"redact_secrets,optional"`
+
+	Redact         map[string]string `hcl:"redact,optional"`
+	MaxColumnBytes map[string]int    `hcl:"max_column_bytes,optional"`
+
+	MaxResourcesPerTable    int `hcl:"max_resources_per_table,optional"`
+	MaxResourcesPerRelation int `hcl:"max_resources_per_relation,optional"`
+	AccountsSample          int `hcl:"accounts_sample,optional"`
+	RegionsSample           int `hcl:"regions_sample,optional"`
+
+	InstanceImages bool `hcl:"instance_images,optional"`
+
+	InstanceAttributes            bool `hcl:"instance_attributes,optional"`
+	InstanceAttributesConcurrency int  `hcl:"instance_attributes_concurrency,optional" default:"10"`
+	HashUserData                  bool `hcl:"hash_user_data,optional"`
 }
 
 func (c Config) Example() string {
@@ -28,6 +47,37 @@ func (c Config) Example() string {
 	// max_retries = 5
 	// The maximum back off delay between attempts. The backoff delays exponentially with a jitter based on the number of attempts. Defaults to 60 seconds.
 	// max_backoff = 30 
+	// Optional. Terraform state files, or directories of them, used to mark which resources are managed by terraform in aws_iac_ownership.
+	// terraform_state_paths = ["./terraform.tfstate", "./states/"]
+	// Optional. Only refresh the resources with these ARNs, replacing their previously fetched rows.
+	// Supported by ec2.instances, ec2.ebs_volumes, ec2.security_groups and s3.buckets, the other tables are skipped and keep their rows.
+	// target_arns = ["arn:aws:ec2:us-east-1:123456789012:security-group/sg-1234", "arn:aws:s3:::my-bucket"]
+	// Optional. Redact secrets found in user data and lambda environment variables before they are stored.
+	// Findings are always written to aws_secret_findings.
+	// redact_secrets = false
+	// Optional. Hash, truncate or drop sensitive columns before they are stored, keyed by <table>.<column>.
+	// The configured columns are listed in aws_redacted_columns.
+	// redact = { "aws_iam_server_certificates.certificate_body" = "hash", "aws_eks_clusters.certificate_authority_data" = "drop" }
+	// Optional. Truncate huge columns to a number of bytes, json columns keep only their size and hash.
+	// max_column_bytes = { "aws_iam_policy_versions.document" = 65536 }
+	// Optional. Development options for fast fetches of a small sample of resources.
+	// Stop fetching a table after this many resources in each account and region.
+	// max_resources_per_table = 10
+	// Stop fetching a relation after this many resources of each parent.
+	// max_resources_per_relation = 5
+	// Only fetch from the first accounts and regions of the configuration.
+	// accounts_sample = 1
+	// regions_sample = 2
+	// Optional. Also fetch the images of other owners, such as public or marketplace AMIs, that instances were
+	// launched from into aws_ec2_images. Images owned by other accounts have no launch permissions.
+	// instance_images = false
+	// Optional. Fetch the user data, termination protection and shutdown behavior of ec2 instances, calling
+	// DescribeInstanceAttribute for each instance. Calls are shared by all accounts and regions and limited to
+	// instance_attributes_concurrency at a time, 10 by default.
+	// instance_attributes = false
+	// instance_attributes_concurrency = 10
+	// Only store the sha256 hash and size of the decoded instance user data, not the user data itself.
+	// hash_user_data = false
 }
 `
 }
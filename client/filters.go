@@ -11,3 +11,10 @@ func DeleteAccountRegionFilter(meta schema.ClientMeta) []interface{} {
 	client := meta.(*Client)
 	return []interface{}{"account_id", client.AccountID, "Region", client.Region}
 }
+
+// DeleteRegionFilter deletes the resources of the client's region regardless of the account, for tables using
+// RegionMultiplex
+func DeleteRegionFilter(meta schema.ClientMeta) []interface{} {
+	client := meta.(*Client)
+	return []interface{}{"region", client.Region}
+}
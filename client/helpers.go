@@ -2,13 +2,15 @@ package client
 
 import (
 	"errors"
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/smithy-go"
 )
 
 //log-group:([a-zA-Z0-9/]+):
-var GroupNameRegex = regexp.MustCompile("arn:aws:logs:[a-z0-9-]+:[0-9]+:log-group:([a-zA-Z0-9-/]+):")
+var GroupNameRegex = regexp.MustCompile("arn:aws[a-z-]*:logs:[a-z0-9-]+:[0-9]+:log-group:([a-zA-Z0-9-/]+):")
 
 func IgnoreAccessDeniedServiceDisabled(err error) bool {
 	var ae smithy.APIError
@@ -22,3 +24,24 @@ func IgnoreAccessDeniedServiceDisabled(err error) bool {
 	}
 	return false
 }
+
+// GenerateResourceARN builds an ARN for resources whose API responses don't include one, in the partition of the
+// region, i.e arn:aws:ec2:us-east-1:123456789012:security-group/sg-1234
+func GenerateResourceARN(service, resourceType, resourceID, region, accountID string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s/%s", RegionPartition(region), service, region, accountID, resourceType, resourceID)
+}
+
+// RegionPartition returns the partition of a region, i.e aws-cn for cn-north-1 and aws for unknown regions
+func RegionPartition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	}
+	return "aws"
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestGenerateResourceARN(t *testing.T) {
+	for region, expected := range map[string]string{
+		"us-east-1":     "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-1",
+		"cn-north-1":    "arn:aws-cn:ec2:cn-north-1:123456789012:vpc/vpc-1",
+		"us-gov-west-1": "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:vpc/vpc-1",
+	} {
+		if arn := GenerateResourceARN("ec2", "vpc", "vpc-1", region, "123456789012"); arn != expected {
+			t.Errorf("expected %s got %s", expected, arn)
+		}
+	}
+}
+
+func TestClientPartition(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-gov-west-1"})
+	global := c.withAccountIDAndRegion("123456789012", GlobalRegion)
+	if p := global.Partition(); p != "aws-us-gov" {
+		t.Fatalf("expected the partition of the configured regions for global clients, got %s", p)
+	}
+	if r := partitionGlobalRegion("cn-north-1"); r != "aws-cn-global" {
+		t.Fatalf("unexpected global region %s", r)
+	}
+}
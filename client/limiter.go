@@ -0,0 +1,31 @@
+package client
+
+import "context"
+
+// Limiter bounds the number of concurrent calls shared by the clients of every account and region
+type Limiter struct {
+	slots chan struct{}
+}
+
+// NewLimiter returns a limiter allowing n concurrent calls, at least one
+func NewLimiter(n int) *Limiter {
+	if n < 1 {
+		n = 1
+	}
+	return &Limiter{slots: make(chan struct{}, n)}
+}
+
+// Acquire blocks until a call is allowed or ctx is done. Every successful Acquire must be followed by a Release.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees the slot of a finished call
+func (l *Limiter) Release() {
+	<-l.slots
+}
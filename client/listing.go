@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"reflect"
+)
+
+// Listing is a paginated listing of resources shared by the tables emitting the resources and the tables analysing
+// them, see SharedList.
+type Listing struct {
+	// Key identifies the listing in the memo
+	Key string
+	// Account is set for the listings of global services, which are shared by the clients of every region
+	Account bool
+	// Pages calls page with the resources of every page, a slice of the same type for each page
+	Pages func(ctx context.Context, page func(items interface{}) error) error
+}
+
+// List returns every resource of the listing in a single slice, listed once per fetch.
+func (c *Client) List(ctx context.Context, l Listing) (interface{}, error) {
+	collect := func(ctx context.Context) (interface{}, error) {
+		var all reflect.Value
+		err := l.Pages(ctx, func(items interface{}) error {
+			if !all.IsValid() {
+				all = reflect.MakeSlice(reflect.TypeOf(items), 0, 0)
+			}
+			all = reflect.AppendSlice(all, reflect.ValueOf(items))
+			return nil
+		})
+		if err != nil || !all.IsValid() {
+			return nil, err
+		}
+		return all.Interface(), nil
+	}
+	if l.Account {
+		return c.SharedAccountList(ctx, l.Key, collect)
+	}
+	return c.SharedList(ctx, l.Key, collect)
+}
+
+// EachPage calls page with the resources of the listing. Capped fetches don't share listings, so page is called with
+// every page as soon as it is listed and the listing stops when the cap cancels ctx, instead of listing every page
+// first. Otherwise page is called once with the shared listing.
+func (c *Client) EachPage(ctx context.Context, l Listing, page func(items interface{}) error) error {
+	if c.maxResourcesPerTable <= 0 {
+		items, err := c.List(ctx, l)
+		if err != nil || items == nil {
+			return err
+		}
+		return page(items)
+	}
+	return l.Pages(ctx, func(items interface{}) error {
+		if reflect.ValueOf(items).Len() == 0 {
+			return nil
+		}
+		return page(items)
+	})
+}
+
+// SendPages sends the resources of the listing to res, see EachPage.
+func (c *Client) SendPages(ctx context.Context, l Listing, res chan interface{}) error {
+	return c.EachPage(ctx, l, func(items interface{}) error {
+		select {
+		case res <- items:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+// pagedListing lists pages of two items until its context is cancelled, counting the pages listed
+func pagedListing(pages *int) Listing {
+	return Listing{Key: "test", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		for i := 0; i < 100; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			*pages++
+			if err := page([]string{"a", "b"}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}}
+}
+
+func TestListing(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", Services{})
+	client := c.withAccountIDAndRegion("111111111111", "us-east-1")
+
+	var pages int
+	for i := 0; i < 2; i++ {
+		v, err := client.List(context.Background(), pagedListing(&pages))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if items := v.([]string); len(items) != 200 {
+			t.Fatalf("expected the items of every page, got %d", len(items))
+		}
+	}
+	if pages != 100 {
+		t.Fatalf("expected the listing to be shared, got %d pages", pages)
+	}
+}
+
+func TestSendPagesCapped(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.maxResourcesPerTable = 3
+
+	var pages int
+	table := WithResourceCaps(&schema.Table{
+		Name: "aws_test",
+		Resolver: func(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+			return meta.(*Client).SendPages(ctx, pagedListing(&pages), res)
+		},
+	})
+	res := make(chan interface{})
+	errc := make(chan error, 1)
+	go func() {
+		defer close(res)
+		errc <- table.Resolver(context.Background(), &c, nil, res)
+	}()
+	count := 0
+	for elem := range res {
+		count += len(elem.([]string))
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatalf("expected 3 resources got %d", count)
+	}
+	// the listing stops soon after the cap is reached instead of listing every page first
+	if pages > 3 {
+		t.Fatalf("expected the listing to stop paginating got %d pages", pages)
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// memo holds values computed once per fetch and shared by the clients of every account and region. It lives as long
+// as the tables of a fetch are resolving, see WithMemoScope.
+type memo struct {
+	mu      sync.Mutex
+	entries map[string]*memoEntry
+	// ctx is the context values are computed in, so a computation doesn't fail the other callers waiting for it
+	// when the caller that started it is cancelled. It is cancelled when the fetch ends.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// resolving counts the table resolves of the fetch in progress
+	resolving int
+}
+
+type memoEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+func newMemo() *memo {
+	m := &memo{}
+	m.reset()
+	return m
+}
+
+// reset drops every value and cancels the computations still running, it must be called with mu held
+func (m *memo) reset() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.entries = make(map[string]*memoEntry)
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+}
+
+func (m *memo) acquire() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resolving++
+}
+
+// release ends a table resolve, the values are dropped once no table is resolving anymore
+func (m *memo) release() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.resolving--
+	if m.resolving <= 0 {
+		m.resolving = 0
+		m.reset()
+	}
+}
+
+func (m *memo) get(ctx context.Context, key string, f func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	for {
+		m.mu.Lock()
+		e, ok := m.entries[key]
+		if !ok {
+			e = &memoEntry{done: make(chan struct{})}
+			m.entries[key] = e
+			go func(ctx context.Context) {
+				e.value, e.err = f(ctx)
+				if e.err != nil {
+					// errors aren't kept, so the next caller computes the value again
+					m.mu.Lock()
+					if m.entries[key] == e {
+						delete(m.entries, key)
+					}
+					m.mu.Unlock()
+				}
+				close(e.done)
+			}(m.ctx)
+		}
+		m.mu.Unlock()
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// a caller that only waited for a failed computation, i.e a throttled call, tries once more itself
+		if e.err == nil || !ok {
+			return e.value, e.err
+		}
+	}
+}
+
+// Memo returns the value computed by f for key, calling f only once per fetch. Tables comparing resources across
+// accounts use it to collect the resources once instead of once per multiplexed client. f is called with a context
+// of the fetch rather than ctx, which only bounds how long the caller waits for the value. Errors aren't kept.
+func (c *Client) Memo(ctx context.Context, key string, f func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	return c.memo.get(ctx, key, f)
+}
+
+// SharedList returns the listing computed by f for key in the account and region of the client, calling f only once
+// per fetch. The tables emitting a listing and the tables analysing it use it, so they evaluate the same data and the
+// APIs are called once. Targeted and capped fetches list only part of the resources, so they call f directly.
+func (c *Client) SharedList(ctx context.Context, key string, f func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if c.targets != nil || c.maxResourcesPerTable > 0 {
+		return f(ctx)
+	}
+	return c.Memo(ctx, fmt.Sprintf("list/%s/%s/%s", key, c.AccountID, c.Region), f)
+}
+
+// SharedAccountList is SharedList for the listings of global services, shared by the clients of every region of the
+// account.
+func (c *Client) SharedAccountList(ctx context.Context, key string, f func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if c.targets != nil || c.maxResourcesPerTable > 0 {
+		return f(ctx)
+	}
+	return c.Memo(ctx, fmt.Sprintf("list/%s/%s", key, c.AccountID), f)
+}
+
+// WithMemoScope wraps the delete filter and resolver of a top level table to count its resolves, so the values of the
+// memo are dropped when the last table of a fetch finishes instead of being kept for the life of the process. The
+// delete filter is called before the previous rows are deleted, so a table starting late in a fetch is counted
+// before the tables fetched quickly finish.
+func WithMemoScope(t *schema.Table) *schema.Table {
+	resolver := t.Resolver
+	deleteFilter := t.DeleteFilter
+	if deleteFilter != nil {
+		t.DeleteFilter = func(meta schema.ClientMeta) []interface{} {
+			meta.(*Client).memo.acquire()
+			return deleteFilter(meta)
+		}
+	}
+	t.Resolver = func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+		m := meta.(*Client).memo
+		if deleteFilter == nil {
+			m.acquire()
+		}
+		defer m.release()
+		return resolver(ctx, meta, parent, res)
+	}
+	return t
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestMemo(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1", "eu-west-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", Services{})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "eu-west-1", Services{})
+
+	var (
+		mu    sync.Mutex
+		calls int
+		wg    sync.WaitGroup
+	)
+	for _, meta := range AccountRegionMultiplex(&c) {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+			v, err := c.Memo(context.Background(), "key", func(context.Context) (interface{}, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				return "value", nil
+			})
+			if err != nil || v != "value" {
+				t.Errorf("unexpected memo result %v, %v", v, err)
+			}
+		}(meta.(*Client))
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+}
+
+func TestSharedList(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1", "eu-west-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", Services{})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "eu-west-1", Services{})
+
+	calls := make(map[string]int)
+	list := func(c *Client) {
+		for _, f := range []func(context.Context, string, func(context.Context) (interface{}, error)) (interface{}, error){c.SharedList, c.SharedAccountList} {
+			if _, err := f(context.Background(), "key", func(context.Context) (interface{}, error) {
+				calls[c.Region]++
+				return nil, nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for i := 0; i < 2; i++ {
+		for _, meta := range AccountRegionMultiplex(&c) {
+			list(meta.(*Client))
+		}
+	}
+	// one regional listing per region and a single account listing
+	if calls["us-east-1"]+calls["eu-west-1"] != 3 {
+		t.Fatalf("unexpected listings %v", calls)
+	}
+
+	capped := c.withAccountIDAndRegion("111111111111", "us-east-1")
+	capped.maxResourcesPerTable = 1
+	calls = make(map[string]int)
+	list(capped)
+	list(capped)
+	if calls["us-east-1"] != 4 {
+		t.Fatalf("expected capped clients to list every time, got %v", calls)
+	}
+}
+
+func TestMemoErrors(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	calls := 0
+	f := func(context.Context) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("throttled")
+		}
+		return "value", nil
+	}
+	if _, err := c.Memo(context.Background(), "key", f); err == nil {
+		t.Fatal("expected the error of the first call")
+	}
+	v, err := c.Memo(context.Background(), "key", f)
+	if err != nil || v != "value" {
+		t.Fatalf("expected the failed value to be computed again, got %v, %v", v, err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestMemoCallerCancel(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	started, release := make(chan struct{}), make(chan struct{})
+	f := func(ctx context.Context) (interface{}, error) {
+		close(started)
+		select {
+		case <-release:
+			return "value", nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error)
+	go func() {
+		_, err := c.Memo(ctx, "key", f)
+		first <- err
+	}()
+	<-started
+	cancel()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled caller to stop waiting, got %v", err)
+	}
+	close(release)
+	v, err := c.Memo(context.Background(), "key", f)
+	if err != nil || v != "value" {
+		t.Fatalf("expected the listing to outlive the cancelled caller, got %v, %v", v, err)
+	}
+}
+
+func TestWithMemoScope(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	calls := 0
+	table := WithMemoScope(&schema.Table{
+		Name: "test",
+		Resolver: func(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, _ chan interface{}) error {
+			_, err := meta.(*Client).Memo(ctx, "key", func(context.Context) (interface{}, error) {
+				calls++
+				return "value", nil
+			})
+			return err
+		},
+	})
+	for i := 0; i < 2; i++ {
+		if err := table.Resolver(context.Background(), &c, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected every fetch to compute the value again, got %d calls", calls)
+	}
+}
@@ -3,6 +3,7 @@ package mocks_test
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingTypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
@@ -104,7 +105,7 @@ func buildCloudfrontDistributionsMock(t *testing.T, ctrl *gomock.Controller) cli
 			Items: []cloudfrontTypes.DistributionSummary{ds},
 		},
 	}
-	m.EXPECT().ListDistributions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+	m.EXPECT().ListDistributions(gomock.Any(), gomock.Any()).Return(
 		cloudfrontOutput,
 		nil,
 	)
@@ -126,7 +127,7 @@ func buildCloudfrontCachePoliciesMock(t *testing.T, ctrl *gomock.Controller) cli
 			Items: []cloudfrontTypes.CachePolicySummary{cp},
 		},
 	}
-	m.EXPECT().ListCachePolicies(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+	m.EXPECT().ListCachePolicies(gomock.Any(), gomock.Any()).Return(
 		cloudfrontOutput,
 		nil,
 	)
@@ -183,10 +184,22 @@ func buildEc2ImagesMock(t *testing.T, ctrl *gomock.Controller) client.Services {
 		t.Fatal(err)
 	}
 
+	// owned images have their launch permissions described
+	g.OwnerId = aws.String("testAccount")
+
 	m.EXPECT().DescribeImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&ec2.DescribeImagesOutput{
 			Images: []ec2Types.Image{g},
 		}, nil)
+	p := ec2Types.LaunchPermission{}
+	if err := faker.FakeData(&p); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeImageAttribute(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeImageAttributeOutput{
+			ImageId:           g.ImageId,
+			LaunchPermissions: []ec2Types.LaunchPermission{p},
+		}, nil)
 	return services
 }
 
@@ -573,6 +586,30 @@ func buildEc2TransitGateways(t *testing.T, ctrl *gomock.Controller) client.Servi
 		t.Fatal(err)
 	}
 
+	tgwr := ec2Types.TransitGatewayRoute{}
+	err = faker.FakeData(&tgwr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tgwrta := ec2Types.TransitGatewayRouteTableAssociation{}
+	err = faker.FakeData(&tgwrta)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tgwrtp := ec2Types.TransitGatewayRouteTablePropagation{}
+	err = faker.FakeData(&tgwrtp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tgwplr := ec2Types.TransitGatewayPrefixListReference{}
+	err = faker.FakeData(&tgwplr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tgwmcd := ec2Types.TransitGatewayMulticastDomain{}
 	err = faker.FakeData(&tgwmcd)
 	if err != nil {
@@ -600,6 +637,23 @@ func buildEc2TransitGateways(t *testing.T, ctrl *gomock.Controller) client.Servi
 			TransitGatewayRouteTables: []ec2Types.TransitGatewayRouteTable{tgwrt},
 		}, nil)
 
+	m.EXPECT().SearchTransitGatewayRoutes(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.SearchTransitGatewayRoutesOutput{
+			Routes: []ec2Types.TransitGatewayRoute{tgwr},
+		}, nil).Times(2)
+	m.EXPECT().GetTransitGatewayRouteTableAssociations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.GetTransitGatewayRouteTableAssociationsOutput{
+			Associations: []ec2Types.TransitGatewayRouteTableAssociation{tgwrta},
+		}, nil)
+	m.EXPECT().GetTransitGatewayRouteTablePropagations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.GetTransitGatewayRouteTablePropagationsOutput{
+			TransitGatewayRouteTablePropagations: []ec2Types.TransitGatewayRouteTablePropagation{tgwrtp},
+		}, nil)
+	m.EXPECT().GetTransitGatewayPrefixListReferences(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.GetTransitGatewayPrefixListReferencesOutput{
+			TransitGatewayPrefixListReferences: []ec2Types.TransitGatewayPrefixListReference{tgwplr},
+		}, nil)
+
 	m.EXPECT().DescribeTransitGatewayMulticastDomains(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&ec2.DescribeTransitGatewayMulticastDomainsOutput{
 			TransitGatewayMulticastDomains: []ec2Types.TransitGatewayMulticastDomain{tgwmcd},
@@ -624,6 +678,18 @@ func buildEc2Vpcs(t *testing.T, ctrl *gomock.Controller) client.Services {
 	if err != nil {
 		t.Fatal(err)
 	}
+	sn := ec2Types.Subnet{}
+	err = faker.FakeData(&sn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sn.VpcId = l.VpcId
+	sn.CidrBlock = aws.String("10.0.0.0/24")
+	sn.AvailableIpAddressCount = 200
+	m.EXPECT().DescribeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSubnetsOutput{
+			Subnets: []ec2Types.Subnet{sn},
+		}, nil)
 	m.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&ec2.DescribeVpcsOutput{
 			Vpcs: []ec2Types.Vpc{l},
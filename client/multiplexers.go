@@ -6,6 +6,9 @@ func AccountMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
 	var l = make([]schema.ClientMeta, 0)
 	client := meta.(*Client)
 	for accountID := range client.ServicesManager.services {
+		if client.targets != nil && !client.targets.matchesAccount(accountID) {
+			continue
+		}
 		l = append(l, client.withAccountID(accountID))
 	}
 	return l
@@ -16,7 +19,44 @@ func AccountRegionMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
 	client := meta.(*Client)
 	for accountID := range client.ServicesManager.services {
 		for _, region := range client.regions {
+			if client.targets != nil && !client.targets.matchesAccountRegion(accountID, region) {
+				continue
+			}
+			l = append(l, client.withAccountIDAndRegion(accountID, region))
+		}
+	}
+	return l
+}
+
+// GlobalMultiplex returns a client per account for services that aren't regional, such as IAM and Route53. The
+// clients' region is GlobalRegion, so tables get a consistent region column, and their services call the global
+// endpoint of the account's partition.
+func GlobalMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
+	var l = make([]schema.ClientMeta, 0)
+	client := meta.(*Client)
+	for accountID := range client.ServicesManager.services {
+		if client.targets != nil && !client.targets.matchesAccount(accountID) {
+			continue
+		}
+		l = append(l, client.withAccountIDAndRegion(accountID, GlobalRegion))
+	}
+	return l
+}
+
+// RegionMultiplex returns a client per region, bound to the first account fetching the region. It's used for
+// reference data, such as instance types, that is the same for every account and would otherwise be stored once per
+// account.
+func RegionMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
+	var l = make([]schema.ClientMeta, 0)
+	client := meta.(*Client)
+	accounts := client.Accounts()
+	for _, region := range client.regions {
+		for _, accountID := range accounts {
+			if client.targets != nil && !client.targets.matchesAccountRegion(accountID, region) {
+				continue
+			}
 			l = append(l, client.withAccountIDAndRegion(accountID, region))
+			break
 		}
 	}
 	return l
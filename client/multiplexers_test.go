@@ -0,0 +1,73 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestGlobalMultiplex(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1", "eu-west-1"})
+	regional := Services{}
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", regional)
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "eu-west-1", regional)
+	c.ServicesManager.InitServicesForAccountAndRegion("222222222222", "us-east-1", regional)
+	c.ServicesManager.InitServicesForAccountAndRegion("222222222222", GlobalRegion, Services{})
+
+	clients := GlobalMultiplex(&c)
+	if len(clients) != 2 {
+		t.Fatalf("expected a client per account, got %d", len(clients))
+	}
+	for _, meta := range clients {
+		client := meta.(*Client)
+		if client.Region != GlobalRegion {
+			t.Errorf("expected region %s, got %s", GlobalRegion, client.Region)
+		}
+		expected := c.ServicesManager.services[client.AccountID][GlobalRegion]
+		if expected == nil {
+			// accounts without global services fall back to the default region
+			expected = c.ServicesManager.services[client.AccountID][defaultRegion]
+		}
+		if client.Services() != expected {
+			t.Errorf("unexpected services of account %s", client.AccountID)
+		}
+	}
+}
+
+func TestPartitionGlobalRegion(t *testing.T) {
+	for region, expected := range map[string]string{
+		"us-east-1":      "aws-global",
+		"eu-west-1":      "aws-global",
+		"cn-north-1":     "aws-cn-global",
+		"us-gov-west-1":  "aws-us-gov-global",
+		"us-iso-east-1":  "aws-iso-global",
+		"us-isob-east-1": "aws-iso-b-global",
+	} {
+		if r := partitionGlobalRegion(region); r != expected {
+			t.Errorf("partitionGlobalRegion(%s) = %s, expected %s", region, r, expected)
+		}
+	}
+}
+
+func TestRegionMultiplex(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1", "eu-west-1"})
+	for _, account := range []string{"222222222222", "111111111111"} {
+		for _, region := range c.regions {
+			c.ServicesManager.InitServicesForAccountAndRegion(account, region, Services{})
+		}
+	}
+
+	clients := RegionMultiplex(&c)
+	if len(clients) != 2 {
+		t.Fatalf("expected a client per region, got %d", len(clients))
+	}
+	for i, meta := range clients {
+		client := meta.(*Client)
+		if client.Region != c.regions[i] {
+			t.Errorf("expected region %s, got %s", c.regions[i], client.Region)
+		}
+		if client.AccountID != "111111111111" {
+			t.Errorf("expected the first account, got %s", client.AccountID)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"regexp"
+	"strings"
+)
+
+const redactedSecret = "[REDACTED]"
+
+// SecretRule detects a single kind of secret. When Pattern has a capture group only the first group is treated
+// as the secret, otherwise the whole match. Matches with a lower shannon entropy than MinEntropy are ignored,
+// which filters out placeholders such as password=changeme.
+type SecretRule struct {
+	Name       string
+	Pattern    *regexp.Regexp
+	MinEntropy float64
+}
+
+// SecretMatch is a secret found by a rule. Match is the text matched by the rule with the secret redacted, which
+// locates the secret in the value. Fingerprint identifies the secret without revealing any of it, so it's safe to
+// store and the same secret can be found across resources.
+type SecretMatch struct {
+	Rule        string
+	Match       string
+	Fingerprint string
+}
+
+// DefaultSecretRules are the rules used to scan plain-text configuration such as user data and environment variables
+var DefaultSecretRules = []SecretRule{
+	{
+		Name:    "aws_access_key_id",
+		Pattern: regexp.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`),
+	},
+	{
+		Name:       "aws_secret_access_key",
+		Pattern:    regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`),
+		MinEntropy: 3.5,
+	},
+	{
+		// the whole key block is the secret, a key without its END line is matched to the end of the value
+		Name: "private_key",
+		Pattern: regexp.MustCompile(`(?s)-----BEGIN (?:RSA |EC |DSA |OPENSSH |ENCRYPTED |PGP )?PRIVATE KEY(?: BLOCK)?-----` +
+			`(?:.*?-----END (?:RSA |EC |DSA |OPENSSH |ENCRYPTED |PGP )?PRIVATE KEY(?: BLOCK)?-----|.*)`),
+	},
+	{
+		Name:    "github_token",
+		Pattern: regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36})\b`),
+	},
+	{
+		Name:    "slack_token",
+		Pattern: regexp.MustCompile(`\b(xox[abposr]-[A-Za-z0-9-]{10,})`),
+	},
+	{
+		Name:       "password",
+		Pattern:    regexp.MustCompile(`(?i)(?:password|passwd|pwd|secret|token|api_?key)["']?\s*[:=]\s*["']?([^\s"']{8,})`),
+		MinEntropy: 3,
+	},
+}
+
+// SecretScanner matches values against a set of secret rules
+type SecretScanner struct {
+	rules []SecretRule
+}
+
+// NewSecretScanner returns a scanner of the given rules, additional rules can be appended to DefaultSecretRules.
+func NewSecretScanner(rules []SecretRule) *SecretScanner {
+	return &SecretScanner{rules: rules}
+}
+
+// Scan returns the secrets found in value
+func (s *SecretScanner) Scan(value string) []SecretMatch {
+	var matches []SecretMatch
+	s.each(value, func(rule SecretRule, match, secret string) {
+		matches = append(matches, SecretMatch{
+			Rule:        rule.Name,
+			Match:       strings.ReplaceAll(match, secret, redactedSecret),
+			Fingerprint: secretFingerprint(rule.Name, secret),
+		})
+	})
+	return matches
+}
+
+// Redact replaces the secrets found in value
+func (s *SecretScanner) Redact(value string) string {
+	s.each(value, func(_ SecretRule, _, secret string) {
+		value = strings.ReplaceAll(value, secret, redactedSecret)
+	})
+	return value
+}
+
+// each calls f once per secret found in value with the text matched by the rule, rules are ordered from specific to
+// generic so the first matching rule reports a secret.
+func (s *SecretScanner) each(value string, f func(rule SecretRule, match, secret string)) {
+	seen := make(map[string]bool)
+	for _, rule := range s.rules {
+		for _, m := range rule.Pattern.FindAllStringSubmatch(value, -1) {
+			secret := m[0]
+			if len(m) > 1 && m[1] != "" {
+				secret = m[1]
+			}
+			if seen[secret] || shannonEntropy(secret) < rule.MinEntropy {
+				continue
+			}
+			seen[secret] = true
+			f(rule, m[0], secret)
+		}
+	}
+}
+
+// secretFingerprint identifies a secret by its rule and a prefix of its sha256, i.e password:sha256:9f86d081884c7d65
+func secretFingerprint(rule, secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return rule + ":sha256:" + hex.EncodeToString(sum[:8])
+}
+
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+	var entropy float64
+	n := float64(len([]rune(s)))
+	for _, c := range counts {
+		p := float64(c) / n
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// SecretScanner returns the scanner used for secret detection
+func (c *Client) SecretScanner() *SecretScanner {
+	if c.secretScanner == nil {
+		return NewSecretScanner(DefaultSecretRules)
+	}
+	return c.secretScanner
+}
+
+// RedactSecrets reports whether detected secrets should be redacted before plain-text configuration is stored
+func (c *Client) RedactSecrets() bool {
+	return c.redactSecrets
+}
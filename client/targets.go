@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// targetResource is a single resource of a targeted refresh, parsed from its ARN
+type targetResource struct {
+	arn.ARN
+	ResourceType string
+	ResourceID   string
+}
+
+// Targets holds the resources of a targeted refresh. When set, multiplexers only return clients for the
+// accounts and regions of the targets and tables that support targeting only fetch and replace these resources.
+type Targets struct {
+	resources []targetResource
+}
+
+// ParseTargets parses the target_arns configuration
+func ParseTargets(arns []string) (*Targets, error) {
+	targets := &Targets{resources: make([]targetResource, 0, len(arns))}
+	for _, a := range arns {
+		parsed, err := arn.Parse(a)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target arn %s: %w", a, err)
+		}
+		t := targetResource{ARN: parsed, ResourceID: parsed.Resource}
+		// resources are either <type>/<id>, <type>:<id> or just <id> as with s3 buckets
+		if i := strings.IndexAny(parsed.Resource, "/:"); i > 0 {
+			t.ResourceType = parsed.Resource[:i]
+			t.ResourceID = parsed.Resource[i+1:]
+		}
+		targets.resources = append(targets.resources, t)
+	}
+	return targets, nil
+}
+
+// matchesAccount reports whether the targets include resources of the given account. ARNs without an account,
+// such as those of s3 buckets, match every account.
+func (t *Targets) matchesAccount(accountID string) bool {
+	for _, r := range t.resources {
+		if r.AccountID == "" || r.AccountID == accountID {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Targets) matchesAccountRegion(accountID, region string) bool {
+	for _, r := range t.resources {
+		if (r.AccountID == "" || r.AccountID == accountID) && (r.Region == "" || r.Region == region) {
+			return true
+		}
+	}
+	return false
+}
+
+// Targeted reports whether the client is running a targeted refresh
+func (c *Client) Targeted() bool {
+	return c.targets != nil
+}
+
+// TargetedResourceIDs returns the ids of the targeted resources of the given service and resource type
+// that belong to the client's account and region.
+func (c *Client) TargetedResourceIDs(service, resourceType string) []string {
+	ids := make([]string, 0)
+	if c.targets == nil {
+		return ids
+	}
+	for _, r := range c.targets.resources {
+		if r.Service != service || r.ResourceType != resourceType {
+			continue
+		}
+		if (r.AccountID != "" && r.AccountID != c.AccountID) || (r.Region != "" && r.Region != c.Region) {
+			continue
+		}
+		ids = append(ids, r.ResourceID)
+	}
+	return ids
+}
+
+// DeleteTargetFilter narrows the delete filter of a table that supports targeting to the targeted resources,
+// so a targeted refresh only replaces their rows. column is the column holding the resource id.
+func DeleteTargetFilter(filter func(meta schema.ClientMeta) []interface{}, service, resourceType, column string) func(meta schema.ClientMeta) []interface{} {
+	return func(meta schema.ClientMeta) []interface{} {
+		c := meta.(*Client)
+		if !c.Targeted() {
+			return filter(meta)
+		}
+		return append(filter(meta), column, c.TargetedResourceIDs(service, resourceType))
+	}
+}
+
+// SkipWhenTargeted makes a table that doesn't support targeting keep its rows during a targeted refresh: it fetches
+// nothing and its delete filter matches no rows, instead of replacing all of its resources.
+func SkipWhenTargeted(t *schema.Table) *schema.Table {
+	resolver := t.Resolver
+	t.Resolver = func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+		if meta.(*Client).Targeted() {
+			return nil
+		}
+		return resolver(ctx, meta, parent, res)
+	}
+	if filter := t.DeleteFilter; filter != nil {
+		t.DeleteFilter = func(meta schema.ClientMeta) []interface{} {
+			if !meta.(*Client).Targeted() {
+				return filter(meta)
+			}
+			// an empty list matches no rows
+			return append(filter(meta), "account_id", []string{})
+		}
+	}
+	return t
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestTargetedRefresh(t *testing.T) {
+	targets, err := ParseTargets([]string{
+		"arn:aws:ec2:us-east-1:111111111111:security-group/sg-1",
+		"arn:aws:ec2:eu-west-1:111111111111:security-group/sg-2",
+		"arn:aws:s3:::bucket",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1", "eu-west-1"})
+	c.targets = targets
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", Services{})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "eu-west-1", Services{})
+	c.ServicesManager.InitServicesForAccountAndRegion("222222222222", "us-east-1", Services{})
+
+	regional := c.withAccountIDAndRegion("111111111111", "us-east-1")
+	if ids := regional.TargetedResourceIDs("ec2", "security-group"); !reflect.DeepEqual(ids, []string{"sg-1"}) {
+		t.Fatalf("unexpected targeted security groups %v", ids)
+	}
+	if ids := regional.TargetedResourceIDs("s3", ""); !reflect.DeepEqual(ids, []string{"bucket"}) {
+		t.Fatalf("unexpected targeted buckets %v", ids)
+	}
+
+	filter := DeleteTargetFilter(DeleteAccountRegionFilter, "ec2", "security-group", "group_id")
+	expected := []interface{}{"account_id", "111111111111", "Region", "us-east-1", "group_id", []string{"sg-1"}}
+	if args := filter(regional); !reflect.DeepEqual(args, expected) {
+		t.Fatalf("unexpected delete filter %v", args)
+	}
+
+	// s3 arns have no account so every account is multiplexed, security groups only match their own account and region
+	if l := AccountMultiplex(&c); len(l) != 2 {
+		t.Fatalf("expected 2 account clients got %d", len(l))
+	}
+	c.targets, _ = ParseTargets([]string{"arn:aws:ec2:eu-west-1:111111111111:security-group/sg-2"})
+	l := AccountRegionMultiplex(&c)
+	if len(l) != 1 || l[0].(*Client).Region != "eu-west-1" {
+		t.Fatalf("expected only the eu-west-1 client got %d clients", len(l))
+	}
+}
+
+func TestSkipWhenTargeted(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("111111111111", "us-east-1", Services{})
+	regional := c.withAccountIDAndRegion("111111111111", "us-east-1")
+
+	fetched := 0
+	table := SkipWhenTargeted(&schema.Table{
+		Resolver: func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+			fetched++
+			return nil
+		},
+		DeleteFilter: DeleteAccountRegionFilter,
+	})
+	if err := table.Resolver(context.Background(), regional, nil, nil); err != nil || fetched != 1 {
+		t.Fatalf("expected the table to be fetched, got %d fetches, %v", fetched, err)
+	}
+	if args := table.DeleteFilter(regional); !reflect.DeepEqual(args, DeleteAccountRegionFilter(regional)) {
+		t.Fatalf("unexpected delete filter %v", args)
+	}
+
+	regional.targets, _ = ParseTargets([]string{"arn:aws:s3:::bucket"})
+	if err := table.Resolver(context.Background(), regional, nil, nil); err != nil || fetched != 1 {
+		t.Fatalf("expected the table to be skipped, got %d fetches, %v", fetched, err)
+	}
+	expected := []interface{}{"account_id", "111111111111", "Region", "us-east-1", "account_id", []string{}}
+	if args := table.DeleteFilter(regional); !reflect.DeepEqual(args, expected) {
+		t.Fatalf("unexpected delete filter %v", args)
+	}
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const defaultTerraformWorkspace = "default"
+
+// TerraformResource is a single managed resource instance found in a terraform state file
+type TerraformResource struct {
+	// Address of the resource instance, i.e module.vpc.aws_subnet.private[0]
+	Address   string
+	Type      string
+	ID        string
+	ARN       string
+	Workspace string
+	StateFile string
+}
+
+// TerraformState indexes all aws resources of the configured terraform state files by their id and arn
+type TerraformState struct {
+	byID  map[string]*TerraformResource
+	byARN map[string]*TerraformResource
+}
+
+// Lookup returns the terraform resource of the given type that manages a resource. Resources are matched
+// by arn when both sides have one, as ids such as iam role names are only unique within an account.
+func (s *TerraformState) Lookup(tfType, id, arn string) *TerraformResource {
+	if s == nil {
+		return nil
+	}
+	if r, ok := s.byARN[arn]; ok && arn != "" {
+		return r
+	}
+	r, ok := s.byID[tfType+"/"+id]
+	if !ok || (arn != "" && r.ARN != "") {
+		return nil
+	}
+	return r
+}
+
+type terraformStateFile struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// LoadTerraformState reads the given terraform state files, or all *.tfstate files under the given directories.
+func LoadTerraformState(paths []string) (*TerraformState, error) {
+	state := &TerraformState{
+		byID:  make(map[string]*TerraformResource),
+		byARN: make(map[string]*TerraformResource),
+	}
+	for _, p := range paths {
+		files, err := terraformStateFiles(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if err := state.load(f); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return state, nil
+}
+
+func terraformStateFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(p, ".tfstate") {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func (s *TerraformState) load(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var f terraformStateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("failed to parse terraform state %s: %w", path, err)
+	}
+	if f.Version != 4 {
+		return fmt.Errorf("unsupported terraform state version %d in %s", f.Version, path)
+	}
+	workspace := terraformWorkspace(path)
+	for _, r := range f.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "aws_") {
+			continue
+		}
+		address := r.Type + "." + r.Name
+		if r.Module != "" {
+			address = r.Module + "." + address
+		}
+		for _, i := range r.Instances {
+			tr := &TerraformResource{
+				Address:   address + terraformIndex(i.IndexKey),
+				Type:      r.Type,
+				Workspace: workspace,
+				StateFile: path,
+			}
+			tr.ID, _ = i.Attributes["id"].(string)
+			tr.ARN, _ = i.Attributes["arn"].(string)
+			if tr.ID != "" {
+				s.byID[tr.Type+"/"+tr.ID] = tr
+			}
+			if tr.ARN != "" {
+				s.byARN[tr.ARN] = tr
+			}
+		}
+	}
+	return nil
+}
+
+// terraformWorkspace infers the workspace from the local backend layout, terraform.tfstate.d/<workspace>/terraform.tfstate
+func terraformWorkspace(path string) string {
+	dir := filepath.Dir(path)
+	if filepath.Base(filepath.Dir(dir)) == "terraform.tfstate.d" {
+		return filepath.Base(dir)
+	}
+	return defaultTerraformWorkspace
+}
+
+func terraformIndex(key interface{}) string {
+	switch k := key.(type) {
+	case float64:
+		return fmt.Sprintf("[%d]", int(k))
+	case string:
+		return fmt.Sprintf("[%q]", k)
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testTerraformState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "web",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "i-1", "arn": "arn:aws:ec2:us-east-1:123456789012:instance/i-1"}}
+      ]
+    },
+    {
+      "module": "module.iam",
+      "mode": "managed",
+      "type": "aws_iam_role",
+      "name": "role",
+      "instances": [
+        {"index_key": "admin", "attributes": {"id": "admin", "arn": "arn:aws:iam::123456789012:role/admin"}}
+      ]
+    },
+    {
+      "mode": "data",
+      "type": "aws_vpc",
+      "name": "default",
+      "instances": [
+        {"attributes": {"id": "vpc-1"}}
+      ]
+    }
+  ]
+}`
+
+func TestLoadTerraformState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	workspaceDir := filepath.Join(dir, "terraform.tfstate.d", "prod")
+	if err := os.MkdirAll(workspaceDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(workspaceDir, "terraform.tfstate"), []byte(testTerraformState), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := LoadTerraformState([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instance := state.Lookup("aws_instance", "i-1", "")
+	if instance == nil {
+		t.Fatal("expected aws_instance to be found by id")
+	}
+	if instance.Address != "aws_instance.web[0]" || instance.Workspace != "prod" {
+		t.Fatalf("unexpected instance address %s workspace %s", instance.Address, instance.Workspace)
+	}
+
+	role := state.Lookup("aws_iam_role", "other", "arn:aws:iam::123456789012:role/admin")
+	if role == nil || role.Address != `module.iam.aws_iam_role.role["admin"]` {
+		t.Fatalf("expected aws_iam_role to be found by arn, got %+v", role)
+	}
+	if state.Lookup("aws_iam_role", "admin", "arn:aws:iam::000000000000:role/admin") != nil {
+		t.Fatal("same role name in another account shouldn't match")
+	}
+	if state.Lookup("aws_vpc", "vpc-1", "") != nil {
+		t.Fatal("data sources shouldn't be indexed")
+	}
+}
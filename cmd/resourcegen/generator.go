@@ -0,0 +1,380 @@
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+// maxFlattenDepth is the depth of nested structs flattened into columns, deeper structs are stored as json
+const maxFlattenDepth = 2
+
+type column struct {
+	Name     string
+	Type     string
+	Resolver string
+}
+
+type table struct {
+	Name      string
+	Resolver  string
+	Columns   []column
+	Relations []*table
+	Root      bool
+	Global    bool
+	// itemType is the sdk type of the relation's items, nil for the table of the resource
+	itemType types.Type
+}
+
+type method struct {
+	Name        string
+	Interface   string
+	Field       string
+	Signature   string
+	Exists      bool
+	OutputField string
+	// pagination token fields of the output and input, empty if the method isn't paginated
+	OutputToken string
+	InputToken  string
+}
+
+type generator struct {
+	Service      string
+	Resource     string
+	FuncName     string
+	Global       bool
+	named        *types.Named
+	typesPkg     *types.Package
+	servicePkg   *types.Package
+	method       *method
+	funcs        []string
+	usesAws      bool
+	usesFmt      bool
+	usesTypesPkg bool
+}
+
+func newGenerator(service, resource string, named *types.Named, global bool) *generator {
+	return &generator{
+		Service:  service,
+		Resource: resource,
+		FuncName: camel(service + "_" + resource),
+		Global:   global,
+		named:    named,
+		typesPkg: named.Obj().Pkg(),
+	}
+}
+
+// setMethod finds the client interface of the service and the output field of the method returning the resources
+func (g *generator) setMethod(servicePkg, clientPkg *types.Package, name string) error {
+	g.servicePkg = servicePkg
+	sdkClient := servicePkg.Scope().Lookup("Client")
+	if sdkClient == nil {
+		return fmt.Errorf("package %s has no client", servicePkg.Path())
+	}
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(sdkClient.Type()), true, servicePkg, name)
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return fmt.Errorf("%s.Client has no method %s", servicePkg.Name(), name)
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 3 || sig.Results().Len() != 2 {
+		return fmt.Errorf("unexpected signature of %s", name)
+	}
+	m := &method{
+		Name:      name,
+		Signature: name + strings.TrimPrefix(types.TypeString(sig, qualifier), "func"),
+	}
+	output := structOf(sig.Results().At(0).Type())
+	input := structOf(sig.Params().At(1).Type())
+	for i := 0; i < output.NumFields(); i++ {
+		f := output.Field(i)
+		if s, ok := f.Type().(*types.Slice); ok && types.Identical(deref(s.Elem()), g.named) {
+			m.OutputField = f.Name()
+		}
+	}
+	if m.OutputField == "" {
+		return fmt.Errorf("output of %s has no slice of %s", name, g.named.Obj().Name())
+	}
+	for _, token := range []string{"NextToken", "NextMarker", "Marker"} {
+		if m.OutputToken == "" && hasField(output, token) {
+			m.OutputToken = token
+		}
+	}
+	for _, token := range []string{"NextToken", "Marker"} {
+		if m.InputToken == "" && hasField(input, token) {
+			m.InputToken = token
+		}
+	}
+	if m.OutputToken == "" || m.InputToken == "" {
+		m.OutputToken, m.InputToken = "", ""
+	}
+
+	// the client interface is the Services field whose methods take the service package's inputs
+	services := structOf(clientPkg.Scope().Lookup("Services").Type())
+	for i := 0; i < services.NumFields(); i++ {
+		f := services.Field(i)
+		iface, ok := f.Type().Underlying().(*types.Interface)
+		if !ok || iface.NumMethods() == 0 {
+			continue
+		}
+		params := iface.Method(0).Type().(*types.Signature).Params()
+		if params.Len() < 2 || !fromPackage(params.At(1).Type(), servicePkg) {
+			continue
+		}
+		m.Field = f.Name()
+		m.Interface = f.Type().(*types.Named).Obj().Name()
+		for j := 0; j < iface.NumMethods(); j++ {
+			if iface.Method(j).Name() == name {
+				m.Exists = true
+			}
+		}
+	}
+	if m.Field == "" {
+		return fmt.Errorf("no client interface for %s in client/services.go, set up the service first", servicePkg.Path())
+	}
+	g.method = m
+	return nil
+}
+
+func (g *generator) rootTable() *table {
+	t := &table{
+		Name:     "aws_" + g.Service + "_" + g.Resource,
+		Resolver: "fetch" + g.FuncName,
+		Root:     true,
+	}
+	t.Columns = append(t.Columns,
+		column{Name: "account_id", Type: "schema.TypeString", Resolver: "client.ResolveAWSAccount"},
+		column{Name: "region", Type: "schema.TypeString", Resolver: "client.ResolveAWSRegion"},
+	)
+	if arn := g.arnColumn(t); arn != nil {
+		t.Columns = append(t.Columns, *arn)
+	}
+	g.walk(t, g.named, nil, 0)
+	return t
+}
+
+// arnColumn generates an arn column for resources that have an id but no arn field
+func (g *generator) arnColumn(t *table) *column {
+	st := structOf(g.named)
+	var idField string
+	for i := 0; i < st.NumFields(); i++ {
+		name := st.Field(i).Name()
+		switch name {
+		case "Arn", "ARN", g.named.Obj().Name() + "Arn", g.named.Obj().Name() + "ARN":
+			return nil
+		}
+		if name == g.named.Obj().Name()+"Id" || (name == "Id" && idField == "") {
+			idField = name
+		}
+	}
+	if idField == "" {
+		return nil
+	}
+	name := "resolve" + singularCamel(t.Name) + "Arn"
+	g.usesAws = true
+	// arns of global resources have no region
+	region := "cl.Region"
+	if g.Global {
+		region = `""`
+	}
+	g.funcs = append(g.funcs, fmt.Sprintf(`func %s(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(%s)
+	return resource.Set(c.Name, client.GenerateResourceARN(%q, %q, aws.ToString(r.%s), %s, cl.AccountID))
+}`, name, g.typeExpr(g.named), g.Service, kebab(g.named.Obj().Name()), idField, region))
+	return &column{Name: "arn", Type: "schema.TypeString", Resolver: name}
+}
+
+// walk adds the columns of a struct to t. path is the field path of nested structs, relations are only
+// created for the struct of the table itself.
+func (g *generator) walk(t *table, named types.Type, path []string, depth int) {
+	st := structOf(named)
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if !f.Exported() || f.Embedded() {
+			continue
+		}
+		fieldPath := append(append([]string{}, path...), f.Name())
+		name := snakePath(fieldPath)
+		ft := deref(f.Type())
+
+		if typ, ok := scalarType(ft); ok {
+			t.Columns = append(t.Columns, g.column(name, typ, fieldPath))
+			continue
+		}
+		switch u := ft.Underlying().(type) {
+		case *types.Struct:
+			if depth+1 < maxFlattenDepth {
+				g.walk(t, ft, fieldPath, depth+1)
+				continue
+			}
+		case *types.Slice:
+			elem := deref(u.Elem())
+			if depth == 0 && isTag(elem) {
+				t.Columns = append(t.Columns, g.tagsColumn(t, name, f.Name(), elem))
+				continue
+			}
+			if _, ok := elem.Underlying().(*types.Struct); ok && depth == 0 {
+				t.Relations = append(t.Relations, g.relation(t, named, f.Name(), elem))
+				continue
+			}
+		}
+		t.Columns = append(t.Columns, g.column(name, "schema.TypeJSON", fieldPath))
+	}
+}
+
+func (g *generator) column(name, typ string, path []string) column {
+	c := column{Name: name, Type: typ}
+	// the sdk resolves columns by the CamelCase of their name, other fields need a path resolver
+	if len(path) > 1 || camel(name) != path[0] {
+		c.Resolver = fmt.Sprintf("schema.PathResolver(%q)", strings.Join(path, "."))
+	}
+	return c
+}
+
+func (g *generator) tagsColumn(t *table, name, field string, tag types.Type) column {
+	resolver := "resolve" + singularCamel(t.Name) + camel(name)
+	key, value := "*t.Key", "t.Value"
+	st := structOf(tag)
+	for i := 0; i < st.NumFields(); i++ {
+		if _, ptr := st.Field(i).Type().(*types.Pointer); ptr {
+			continue
+		}
+		switch st.Field(i).Name() {
+		case "Key":
+			key = "t.Key"
+		case "Value":
+			value = "aws.String(t.Value)"
+			g.usesAws = true
+		}
+	}
+	g.funcs = append(g.funcs, fmt.Sprintf(`func %s(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(%s)
+	tags := map[string]*string{}
+	for _, t := range r.%s {
+		tags[%s] = %s
+	}
+	return resource.Set(c.Name, tags)
+}`, resolver, g.itemTypeExpr(t), field, key, value))
+	return column{Name: name, Type: "schema.TypeJSON", Resolver: resolver}
+}
+
+func (g *generator) relation(parent *table, parentType types.Type, field string, elem types.Type) *table {
+	prefix := "aws_" + g.Service + "_"
+	name := singular(parent.Name) + "_" + snake(field)
+	rel := &table{
+		Name:     name,
+		Resolver: "fetch" + camel(strings.TrimPrefix(name, "aws_")),
+		itemType: elem,
+	}
+	rel.Columns = append(rel.Columns, column{
+		Name:     singular(strings.TrimPrefix(parent.Name, prefix)) + "_id",
+		Type:     "schema.TypeUUID",
+		Resolver: "schema.ParentIdResolver",
+	})
+	g.usesFmt = true
+	g.funcs = append(g.funcs, fmt.Sprintf(`func %s(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(%s)
+	if !ok {
+		return fmt.Errorf("expected %s but got %%T", parent.Item)
+	}
+	res <- r.%s
+	return nil
+}`, rel.Resolver, g.typeExpr(parentType), g.typeExpr(parentType), field))
+	g.walk(rel, elem, nil, 0)
+	return rel
+}
+
+func (g *generator) itemTypeExpr(t *table) string {
+	if t.itemType == nil {
+		return g.typeExpr(g.named)
+	}
+	return g.typeExpr(t.itemType)
+}
+
+// typeExpr returns the type as used in the generated file, where the sdk types package is imported as types
+func (g *generator) typeExpr(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == g.typesPkg {
+			g.usesTypesPkg = true
+			return "types"
+		}
+		return p.Name()
+	})
+}
+
+// scalarType maps a field type to a column type, enums are strings and slices of scalars are arrays
+func scalarType(t types.Type) (string, bool) {
+	if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time" {
+		return "schema.TypeTimestamp", true
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch u.Kind() {
+		case types.String:
+			return "schema.TypeString", true
+		case types.Bool:
+			return "schema.TypeBool", true
+		case types.Int8, types.Int16, types.Uint8:
+			return "schema.TypeSmallInt", true
+		case types.Int32, types.Uint16:
+			return "schema.TypeInt", true
+		case types.Int, types.Int64, types.Uint32:
+			return "schema.TypeBigInt", true
+		case types.Float32, types.Float64:
+			return "schema.TypeFloat", true
+		}
+	case *types.Slice:
+		if b, ok := deref(u.Elem()).Underlying().(*types.Basic); ok {
+			switch b.Kind() {
+			case types.String:
+				return "schema.TypeStringArray", true
+			case types.Int:
+				return "schema.TypeIntArray", true
+			case types.Byte:
+				return "schema.TypeByteArray", true
+			}
+		}
+	}
+	return "", false
+}
+
+// isTag reports whether t is a key value pair such as ec2 types.Tag
+func isTag(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Name() != "Tag" {
+		return false
+	}
+	st := structOf(t)
+	return st != nil && hasField(st, "Key") && hasField(st, "Value")
+}
+
+func structOf(t types.Type) *types.Struct {
+	st, _ := deref(t).Underlying().(*types.Struct)
+	return st
+}
+
+func hasField(st *types.Struct, name string) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func deref(t types.Type) types.Type {
+	if p, ok := t.(*types.Pointer); ok {
+		return p.Elem()
+	}
+	return t
+}
+
+func fromPackage(t types.Type, pkg *types.Package) bool {
+	named, ok := deref(t).(*types.Named)
+	return ok && named.Obj().Pkg() == pkg
+}
+
+func qualifier(p *types.Package) string {
+	return p.Name()
+}
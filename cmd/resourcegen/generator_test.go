@@ -0,0 +1,100 @@
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+const widgetSource = `package types
+
+import "time"
+
+type Tag struct {
+	Key   *string
+	Value *string
+}
+
+type Attachment struct {
+	Device *string
+}
+
+type Endpoint struct {
+	Address *string
+	Port    int32
+}
+
+type Widget struct {
+	WidgetId    *string
+	DBName      *string
+	Created     *time.Time
+	Endpoint    *Endpoint
+	Tags        []Tag
+	Attachments []Attachment
+	Ids         []string
+	Settings    map[string]string
+}
+`
+
+func TestGenerateTable(t *testing.T) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "types.go", widgetSource, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check("example.com/service/widgets/types", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := newGenerator("widgets", "widgets", pkg.Scope().Lookup("Widget").Type().(*types.Named), false)
+	src, err := g.table()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`Name:         "aws_widgets_widgets"`,
+		`Resolver: resolveWidgetsWidgetArn`,
+		`client.GenerateResourceARN("widgets", "widget", aws.ToString(r.WidgetId), cl.Region, cl.AccountID)`,
+		// acronyms don't match the sdk's CamelCase lookup
+		`Resolver: schema.PathResolver("DBName")`,
+		`Type: schema.TypeTimestamp`,
+		`Resolver: schema.PathResolver("Endpoint.Address")`,
+		`Resolver: resolveWidgetsWidgetTags`,
+		`Name:     "aws_widgets_widget_attachments"`,
+		`Name:     "widget_id"`,
+		`Resolver: schema.ParentIdResolver`,
+		`Type: schema.TypeStringArray`,
+		`Name: "settings",`,
+		"//                                               Table Resolver Functions",
+	} {
+		if !strings.Contains(string(src), expected) {
+			t.Errorf("generated table is missing %s:\n%s", expected, src)
+		}
+	}
+}
+
+func TestNaming(t *testing.T) {
+	for in, expected := range map[string]string{
+		"DBInstanceIdentifier": "db_instance_identifier",
+		"KmsKeyId":             "kms_key_id",
+		"VolumeSize":           "volume_size",
+		"ARN":                  "arn",
+	} {
+		if s := snake(in); s != expected {
+			t.Errorf("snake(%s) = %s, expected %s", in, s, expected)
+		}
+	}
+	if s := camel("kms_key_id"); s != "KmsKeyId" {
+		t.Errorf("unexpected camel %s", s)
+	}
+	for in, expected := range map[string]string{"policies": "policy", "addresses": "address", "volumes": "volume"} {
+		if s := singular(in); s != expected {
+			t.Errorf("singular(%s) = %s, expected %s", in, s, expected)
+		}
+	}
+}
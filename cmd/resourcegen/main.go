@@ -0,0 +1,114 @@
+// Command resourcegen generates the skeleton of a new resource from an aws-sdk-go-v2 type.
+//
+// It reflects over the type, flattening nested structs into columns, turning slices of structs into relations and
+// []Tag into a tags column, and writes resources/<service>_<resource>.go with a test using a faker mock builder.
+// The client interface method the fetch resolver calls is printed, as it has to be added to client/services.go
+// before running go generate. Run it from the repository root:
+//
+//	go run ./cmd/resourcegen -type github.com/aws/aws-sdk-go-v2/service/ec2/types.Snapshot \
+//		-service ec2 -resource snapshots -method DescribeSnapshots
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const clientPackage = "github.com/cloudquery/cq-provider-aws/client"
+
+func main() {
+	typeName := flag.String("type", "", "full path of the sdk type of the resource, i.e github.com/aws/aws-sdk-go-v2/service/ec2/types.Snapshot")
+	service := flag.String("service", "", "service prefix of the table, i.e ec2")
+	resource := flag.String("resource", "", "plural resource name of the table, i.e snapshots")
+	method := flag.String("method", "", "optional client method listing the resources, i.e DescribeSnapshots")
+	global := flag.Bool("global", false, "multiplex the table with client.GlobalMultiplex for services that aren't regional, such as iam")
+	output := flag.String("output", "resources", "directory to write the table and its test to")
+	force := flag.Bool("force", false, "overwrite existing files")
+	flag.Parse()
+	if *typeName == "" || *service == "" || *resource == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(*typeName, *service, *resource, *method, *global, *output, *force); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(typeName, service, resource, method string, global bool, output string, force bool) error {
+	i := strings.LastIndex(typeName, ".")
+	if i < 0 {
+		return fmt.Errorf("invalid type %s, expected <package path>.<type>", typeName)
+	}
+	imp := importer.ForCompiler(token.NewFileSet(), "source", nil)
+	typesPkg, err := imp.Import(typeName[:i])
+	if err != nil {
+		return err
+	}
+	obj := typesPkg.Scope().Lookup(typeName[i+1:])
+	if obj == nil {
+		return fmt.Errorf("type %s not found", typeName)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return fmt.Errorf("%s isn't a named type", typeName)
+	}
+
+	g := newGenerator(service, resource, named, global)
+	if method != "" {
+		// the service package holds the client of the types package, i.e service/ec2 of service/ec2/types
+		servicePkg, err := imp.Import(strings.TrimSuffix(typesPkg.Path(), "/types"))
+		if err != nil {
+			return err
+		}
+		clientPkg, err := imp.Import(clientPackage)
+		if err != nil {
+			return err
+		}
+		if err := g.setMethod(servicePkg, clientPkg, method); err != nil {
+			return err
+		}
+	}
+
+	base := filepath.Join(output, service+"_"+resource)
+	table, err := g.table()
+	if err != nil {
+		return err
+	}
+	if err := writeFile(base+".go", table, force); err != nil {
+		return err
+	}
+	fmt.Printf("generated %s.go\n", base)
+	// the mock builder needs the client method, without it the fetch resolver is left for the developer
+	if g.method == nil {
+		fmt.Println("\nimplement the fetch resolver, and set -method to also generate a test with a mock builder")
+	} else {
+		test, err := g.test()
+		if err != nil {
+			return err
+		}
+		if err := writeFile(base+"_test.go", test, force); err != nil {
+			return err
+		}
+		fmt.Printf("generated %s_test.go\n", base)
+	}
+	if g.method != nil && !g.method.Exists {
+		fmt.Printf("\nadd the method to %s in client/services.go and run go generate client/services.go:\n\t%s\n", g.method.Interface, g.method.Signature)
+	}
+	fmt.Printf("\nregister the table in resources/provider.go:\n\t%q: %s(),\n", service+"."+resource, g.FuncName)
+	return nil
+}
+
+func writeFile(path string, data []byte, force bool) error {
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("%s already exists, use -force to overwrite it", path)
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// camel mirrors the strcase.ToCamel conversion the sdk uses to resolve columns by name
+func camel(s string) string {
+	var b strings.Builder
+	capNext := true
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r):
+			if capNext {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			capNext = false
+		case unicode.IsDigit(r):
+			b.WriteRune(r)
+			capNext = true
+		default:
+			capNext = r == '_'
+		}
+	}
+	return b.String()
+}
+
+// snake converts a field name to a column name, keeping acronyms together, i.e DBInstanceArn is db_instance_arn
+func snake(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func snakePath(path []string) string {
+	parts := make([]string, len(path))
+	for i, p := range path {
+		parts[i] = snake(p)
+	}
+	return strings.Join(parts, "_")
+}
+
+// kebab converts a type name to an arn resource type, i.e SecurityGroup is security-group
+func kebab(s string) string {
+	return strings.ReplaceAll(snake(s), "_", "-")
+}
+
+// singular returns the singular of a plural snake case name
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "sses"), strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	}
+	return strings.TrimSuffix(s, "s")
+}
+
+// singularCamel returns the resolver name prefix of a table, i.e aws_ec2_snapshots is Ec2Snapshot
+func singularCamel(tableName string) string {
+	return camel(singular(strings.TrimPrefix(tableName, "aws_")))
+}
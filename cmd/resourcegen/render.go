@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+)
+
+var tableTemplate = template.Must(template.New("table").Parse(`{
+	Name:     "{{.Name}}",
+	Resolver: {{.Resolver}},
+{{- if .Root}}
+	Multiplex:    client.{{if .Global}}GlobalMultiplex{{else}}AccountRegionMultiplex{{end}},
+	IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+	DeleteFilter: client.{{if .Global}}DeleteAccountFilter{{else}}DeleteAccountRegionFilter{{end}},
+{{- end}}
+	Columns: []schema.Column{
+	{{- range .Columns}}
+		{
+			Name: "{{.Name}}",
+			Type: {{.Type}},
+			{{- if .Resolver}}
+			Resolver: {{.Resolver}},
+			{{- end}}
+		},
+	{{- end}}
+	},
+{{- if .Relations}}
+	Relations: []*schema.Table{
+	{{- range .Relations}}
+		{{template "table" .}},
+	{{- end}}
+	},
+{{- end}}
+}`))
+
+const (
+	resolversHeaderPlaceholder = "// resolvers"
+	resolversHeader            = `// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================`
+)
+
+// table renders resources/<service>_<resource>.go
+func (g *generator) table() ([]byte, error) {
+	root := g.rootTable()
+	root.Global = g.Global
+	var def bytes.Buffer
+	if err := tableTemplate.Execute(&def, root); err != nil {
+		return nil, err
+	}
+	fetch := g.fetch()
+
+	std := []string{`"context"`}
+	if g.usesFmt {
+		std = append(std, `"fmt"`)
+	}
+	imports := []string{`"github.com/cloudquery/cq-provider-aws/client"`, `"github.com/cloudquery/cq-provider-sdk/provider/schema"`}
+	if g.usesAws {
+		imports = append(imports, `"github.com/aws/aws-sdk-go-v2/aws"`)
+	}
+	if g.method != nil {
+		imports = append(imports, fmt.Sprintf("%q", g.servicePkg.Path()))
+	}
+	if g.usesTypesPkg {
+		imports = append(imports, fmt.Sprintf("%q", g.typesPkg.Path()))
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "package resources\n\nimport (\n%s\n\n%s\n)\n\n", strings.Join(std, "\n"), strings.Join(imports, "\n"))
+	fmt.Fprintf(&b, "func %s() *schema.Table {\n\treturn &schema.Table%s\n}\n\n", g.FuncName, def.String())
+	b.WriteString(resolversHeaderPlaceholder + "\n")
+	b.WriteString(fetch + "\n")
+	for _, f := range g.funcs {
+		b.WriteString(f + "\n")
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	// the header is added after formatting, as newer gofmt versions reformat its indented line
+	return bytes.Replace(src, []byte(resolversHeaderPlaceholder), []byte(resolversHeader), 1), nil
+}
+
+// fetch renders the table resolver calling the client method, or a stub when no method was given
+func (g *generator) fetch() string {
+	name := "fetch" + g.FuncName
+	m := g.method
+	if m == nil {
+		return fmt.Sprintf(`func %s(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	// TODO: list the resources and send them to res, taking pagination into account
+	return nil
+}`, name)
+	}
+	pkg := g.servicePkg.Name()
+	options := ""
+	if !g.Global {
+		options = fmt.Sprintf(", func(o *%s.Options) {\n\t\to.Region = c.Region\n\t}", pkg)
+	}
+	if m.OutputToken == "" {
+		return fmt.Sprintf(`func %s(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config %s.%sInput
+	c := meta.(*client.Client)
+	svc := c.Services().%s
+	output, err := svc.%s(ctx, &config%s)
+	if err != nil {
+		return err
+	}
+	res <- output.%s
+	return nil
+}`, name, pkg, m.Name, m.Field, m.Name, options, m.OutputField)
+	}
+	g.usesAws = true
+	return fmt.Sprintf(`func %s(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config %s.%sInput
+	c := meta.(*client.Client)
+	svc := c.Services().%s
+	for {
+		output, err := svc.%s(ctx, &config%s)
+		if err != nil {
+			return err
+		}
+		res <- output.%s
+		if aws.ToString(output.%s) == "" {
+			break
+		}
+		config.%s = output.%s
+	}
+	return nil
+}`, name, pkg, m.Name, m.Field, m.Name, options, m.OutputField, m.OutputToken, m.InputToken, m.OutputToken)
+}
+
+// test renders a test with a faker mock builder of the client method
+func (g *generator) test() ([]byte, error) {
+	m := g.method
+	item := g.named.Obj().Name()
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `package resources
+
+import (
+	"testing"
+
+	%q
+	%q
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func build%[3]s(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMock%[4]s(ctrl)
+
+	r := types.%[5]s{}
+	if err := faker.FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().%[6]s(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&%[7]s.%[6]sOutput{
+			%[8]s: []types.%[5]s{r},
+		}, nil)
+
+	return client.Services{
+		%[9]s: m,
+	}
+}
+
+func Test%[3]s(t *testing.T) {
+	awsTestHelper(t, %[3]s(), build%[3]s)
+}
+`, g.servicePkg.Path(), g.typesPkg.Path(), g.FuncName, m.Interface, item, m.Name, g.servicePkg.Name(), m.OutputField, m.Field)
+	return format.Source(b.Bytes())
+}
@@ -18,7 +18,7 @@ func AccessAnalyzerAnalyzer() *schema.Table {
 		Resolver:     fetchAccessAnalyzerAnalyzers,
 		Multiplex:    client.AccountRegionMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountFilter,
+		DeleteFilter: client.DeleteAccountRegionFilter,
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -0,0 +1,403 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func AutoscalingGroups() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_autoscaling_groups",
+		Resolver:     fetchAutoscalingGroups,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "auto_scaling_group_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zones",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "created_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "default_cooldown",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "desired_capacity",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "health_check_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "max_size",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "min_size",
+				Type: schema.TypeInt,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("AutoScalingGroupARN"),
+			},
+			{
+				Name: "capacity_rebalance",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "health_check_grace_period",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "launch_configuration_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "launch_template_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchTemplate.LaunchTemplateId"),
+			},
+			{
+				Name:     "launch_template_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchTemplate.LaunchTemplateName"),
+			},
+			{
+				Name:     "launch_template_version",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchTemplate.Version"),
+			},
+			{
+				Name: "load_balancer_names",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "max_instance_lifetime",
+				Type: schema.TypeInt,
+			},
+			{
+				Name:     "mixed_instances_policy_on_demand_allocation_strategy",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.OnDemandAllocationStrategy"),
+			},
+			{
+				Name:     "mixed_instances_policy_on_demand_base_capacity",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.OnDemandBaseCapacity"),
+			},
+			{
+				Name:     "mixed_instances_policy_on_demand_percentage_above_base_capacity",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity"),
+			},
+			{
+				Name:     "mixed_instances_policy_spot_allocation_strategy",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.SpotAllocationStrategy"),
+			},
+			{
+				Name:     "mixed_instances_policy_spot_instance_pools",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.SpotInstancePools"),
+			},
+			{
+				Name:     "mixed_instances_policy_spot_max_price",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.InstancesDistribution.SpotMaxPrice"),
+			},
+			{
+				Name:     "mixed_instances_policy_launch_template_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.LaunchTemplateId"),
+			},
+			{
+				Name:     "mixed_instances_policy_launch_template_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.LaunchTemplateName"),
+			},
+			{
+				Name:     "mixed_instances_policy_launch_template_version",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.Version"),
+			},
+			{
+				Name: "new_instances_protected_from_scale_in",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "placement_group",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "service_linked_role_arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("ServiceLinkedRoleARN"),
+			},
+			{
+				Name: "status",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "target_group_arns",
+				Type:     schema.TypeStringArray,
+				Resolver: schema.PathResolver("TargetGroupARNs"),
+			},
+			{
+				Name: "termination_policies",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name:     "vpc_zone_identifier",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("VPCZoneIdentifier"),
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_autoscaling_group_enabled_metrics",
+				Resolver: fetchAutoscalingGroupEnabledMetrics,
+				Columns: []schema.Column{
+					{
+						Name:     "group_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "granularity",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "metric",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_autoscaling_group_instances",
+				Resolver: fetchAutoscalingGroupInstances,
+				Columns: []schema.Column{
+					{
+						Name:     "group_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "availability_zone",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "health_status",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "instance_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "lifecycle_state",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "protected_from_scale_in",
+						Type: schema.TypeBool,
+					},
+					{
+						Name: "instance_type",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "launch_configuration_name",
+						Type: schema.TypeString,
+					},
+					{
+						Name:     "launch_template_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplate.LaunchTemplateId"),
+					},
+					{
+						Name:     "launch_template_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplate.LaunchTemplateName"),
+					},
+					{
+						Name:     "launch_template_version",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplate.Version"),
+					},
+					{
+						Name: "weighted_capacity",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_autoscaling_group_mixed_instances_policy_overrides",
+				Resolver: fetchAutoscalingGroupMixedInstancesPolicyOverrides,
+				Columns: []schema.Column{
+					{
+						Name:     "group_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "instance_type",
+						Type: schema.TypeString,
+					},
+					{
+						Name:     "launch_template_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateSpecification.LaunchTemplateId"),
+					},
+					{
+						Name:     "launch_template_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateSpecification.LaunchTemplateName"),
+					},
+					{
+						Name:     "launch_template_version",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateSpecification.Version"),
+					},
+					{
+						Name: "weighted_capacity",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_autoscaling_group_suspended_processes",
+				Resolver: fetchAutoscalingGroupSuspendedProcesses,
+				Columns: []schema.Column{
+					{
+						Name:     "group_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "process_name",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "suspension_reason",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_autoscaling_group_tags",
+				Resolver: fetchAutoscalingGroupTags,
+				Columns: []schema.Column{
+					{
+						Name:     "group_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "key",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "propagate_at_launch",
+						Type: schema.TypeBool,
+					},
+					{
+						Name: "value",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchAutoscalingGroups(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config autoscaling.DescribeAutoScalingGroupsInput
+	c := meta.(*client.Client)
+	svc := c.Services().Autoscaling
+	for {
+		output, err := svc.DescribeAutoScalingGroups(ctx, &config, func(o *autoscaling.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.AutoScalingGroups
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func fetchAutoscalingGroupEnabledMetrics(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("expected types.AutoScalingGroup but got %T", parent.Item)
+	}
+	res <- r.EnabledMetrics
+	return nil
+}
+func fetchAutoscalingGroupInstances(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("expected types.AutoScalingGroup but got %T", parent.Item)
+	}
+	res <- r.Instances
+	return nil
+}
+func fetchAutoscalingGroupMixedInstancesPolicyOverrides(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("expected types.AutoScalingGroup but got %T", parent.Item)
+	}
+	if r.MixedInstancesPolicy == nil || r.MixedInstancesPolicy.LaunchTemplate == nil {
+		return nil
+	}
+	res <- r.MixedInstancesPolicy.LaunchTemplate.Overrides
+	return nil
+}
+func fetchAutoscalingGroupSuspendedProcesses(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("expected types.AutoScalingGroup but got %T", parent.Item)
+	}
+	res <- r.SuspendedProcesses
+	return nil
+}
+func fetchAutoscalingGroupTags(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.AutoScalingGroup)
+	if !ok {
+		return fmt.Errorf("expected types.AutoScalingGroup but got %T", parent.Item)
+	}
+	res <- r.Tags
+	return nil
+}
@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildAutoscalingGroups(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockAutoscalingClient(ctrl)
+
+	g := types.AutoScalingGroup{}
+	err := faker.FakeData(&g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.AutoScalingGroupName = aws.String("test-group")
+	g.AutoScalingGroupARN = aws.String("arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:6d9e4a1c-7b44-4b55-8c2d-1f0b0a6e8e21:autoScalingGroupName/test-group")
+	g.VPCZoneIdentifier = aws.String("subnet-0a1b2c3d,subnet-4e5f6a7b")
+	g.HealthCheckType = aws.String("EC2")
+
+	lt := types.LaunchTemplateSpecification{
+		LaunchTemplateId:   aws.String("lt-0a1b2c3d4e5f6a7b8"),
+		LaunchTemplateName: aws.String("test-template"),
+		Version:            aws.String("$Latest"),
+	}
+	g.LaunchTemplate = &lt
+	// the overrides are only fetched for groups with a mixed instances policy
+	g.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification = &lt
+	for i := range g.MixedInstancesPolicy.LaunchTemplate.Overrides {
+		g.MixedInstancesPolicy.LaunchTemplate.Overrides[i].InstanceType = aws.String("t3.micro")
+		g.MixedInstancesPolicy.LaunchTemplate.Overrides[i].LaunchTemplateSpecification = &lt
+	}
+	for i := range g.Instances {
+		g.Instances[i].InstanceId = aws.String("i-0a1b2c3d4e5f6a7b8")
+		g.Instances[i].HealthStatus = aws.String("Healthy")
+		g.Instances[i].LifecycleState = types.LifecycleStateInService
+		g.Instances[i].LaunchTemplate = &lt
+	}
+	for i := range g.Tags {
+		g.Tags[i].ResourceId = g.AutoScalingGroupName
+		g.Tags[i].ResourceType = aws.String("auto-scaling-group")
+	}
+
+	m.EXPECT().DescribeAutoScalingGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []types.AutoScalingGroup{g},
+		}, nil)
+
+	return client.Services{
+		Autoscaling: m,
+	}
+}
+
+func TestAutoscalingGroups(t *testing.T) {
+	awsTestHelper(t, AutoscalingGroups(), buildAutoscalingGroups)
+}
@@ -2,9 +2,10 @@ package resources
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -116,8 +117,9 @@ func AutoscalingLaunchConfigurations() *schema.Table {
 				Type: schema.TypeString,
 			},
 			{
-				Name: "user_data",
-				Type: schema.TypeString,
+				Name:     "user_data",
+				Type:     schema.TypeString,
+				Resolver: resolveAutoscalingLaunchConfigurationUserData,
 			},
 		},
 		Relations: []*schema.Table{
@@ -183,23 +185,23 @@ func AutoscalingLaunchConfigurations() *schema.Table {
 // ====================================================================================================================
 func fetchAutoscalingLaunchConfigurations(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	c := meta.(*client.Client)
-	svc := c.Services().Autoscaling
-	config := autoscaling.DescribeLaunchConfigurationsInput{}
-	for {
-		output, err := svc.DescribeLaunchConfigurations(ctx, &config, func(o *autoscaling.Options) {
-			o.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- output.LaunchConfigurations
-
-		if aws.ToString(output.NextToken) == "" {
-			break
-		}
-		config.NextToken = output.NextToken
+	return c.SendPages(ctx, autoscalingLaunchConfigurationsListing(c), res)
+}
+func resolveAutoscalingLaunchConfigurationUserData(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	lc, ok := resource.Item.(types.LaunchConfiguration)
+	if !ok {
+		return fmt.Errorf("not launch configuration")
 	}
-	return nil
+	userData := aws.ToString(lc.UserData)
+	if !meta.(*client.Client).RedactSecrets() {
+		return resource.Set(c.Name, userData)
+	}
+	redacted := meta.(*client.Client).SecretScanner().Redact(decodeUserData(userData))
+	// keep the stored value base64 encoded like the unredacted user data
+	if _, err := base64.StdEncoding.DecodeString(userData); err == nil {
+		redacted = base64.StdEncoding.EncodeToString([]byte(redacted))
+	}
+	return resource.Set(c.Name, redacted)
 }
 func fetchAutoscalingLaunchConfigurationBlockDeviceMappings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	res <- parent.Item.(types.LaunchConfiguration).BlockDeviceMappings
@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func AutoscalingScalingPolicies() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_autoscaling_scaling_policies",
+		Resolver:     fetchAutoscalingScalingPolicies,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "adjustment_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "auto_scaling_group_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "cooldown",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "enabled",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "estimated_instance_warmup",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "metric_aggregation_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "min_adjustment_magnitude",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "min_adjustment_step",
+				Type: schema.TypeInt,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PolicyARN"),
+			},
+			{
+				Name: "policy_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "policy_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "scaling_adjustment",
+				Type: schema.TypeInt,
+			},
+			{
+				Name:     "target_tracking_configuration_target_value",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("TargetTrackingConfiguration.TargetValue"),
+			},
+			{
+				Name:     "target_tracking_configuration_customized_metric_specification",
+				Type:     schema.TypeJSON,
+				Resolver: resolveAutoscalingScalingPolicyTargetTrackingConfigurationCustomizedMetricSpecification,
+			},
+			{
+				Name:     "target_tracking_configuration_disable_scale_in",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("TargetTrackingConfiguration.DisableScaleIn"),
+			},
+			{
+				Name:     "target_tracking_configuration_predefined_metric_specification",
+				Type:     schema.TypeJSON,
+				Resolver: resolveAutoscalingScalingPolicyTargetTrackingConfigurationPredefinedMetricSpecification,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_autoscaling_scaling_policy_alarms",
+				Resolver: fetchAutoscalingScalingPolicyAlarms,
+				Columns: []schema.Column{
+					{
+						Name:     "scaling_policy_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name:     "alarm_arn",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("AlarmARN"),
+					},
+					{
+						Name: "alarm_name",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_autoscaling_scaling_policy_step_adjustments",
+				Resolver: fetchAutoscalingScalingPolicyStepAdjustments,
+				Columns: []schema.Column{
+					{
+						Name:     "scaling_policy_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "scaling_adjustment",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "metric_interval_lower_bound",
+						Type: schema.TypeFloat,
+					},
+					{
+						Name: "metric_interval_upper_bound",
+						Type: schema.TypeFloat,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchAutoscalingScalingPolicies(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config autoscaling.DescribePoliciesInput
+	c := meta.(*client.Client)
+	svc := c.Services().Autoscaling
+	for {
+		output, err := svc.DescribePolicies(ctx, &config, func(o *autoscaling.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.ScalingPolicies
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveAutoscalingScalingPolicyTargetTrackingConfigurationCustomizedMetricSpecification(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.ScalingPolicy)
+	if r.TargetTrackingConfiguration == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.TargetTrackingConfiguration.CustomizedMetricSpecification)
+}
+func resolveAutoscalingScalingPolicyTargetTrackingConfigurationPredefinedMetricSpecification(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.ScalingPolicy)
+	if r.TargetTrackingConfiguration == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.TargetTrackingConfiguration.PredefinedMetricSpecification)
+}
+func fetchAutoscalingScalingPolicyAlarms(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.ScalingPolicy)
+	if !ok {
+		return fmt.Errorf("expected types.ScalingPolicy but got %T", parent.Item)
+	}
+	res <- r.Alarms
+	return nil
+}
+func fetchAutoscalingScalingPolicyStepAdjustments(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.ScalingPolicy)
+	if !ok {
+		return fmt.Errorf("expected types.ScalingPolicy but got %T", parent.Item)
+	}
+	res <- r.StepAdjustments
+	return nil
+}
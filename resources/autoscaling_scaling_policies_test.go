@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildAutoscalingScalingPolicies(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockAutoscalingClient(ctrl)
+
+	p := types.ScalingPolicy{}
+	err := faker.FakeData(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.AutoScalingGroupName = aws.String("test-group")
+	p.PolicyName = aws.String("test-policy")
+	p.PolicyARN = aws.String("arn:aws:autoscaling:us-east-1:123456789012:scalingPolicy:1f0b0a6e-7b44-4b55-8c2d-6d9e4a1c8e21:autoScalingGroupName/test-group:policyName/test-policy")
+	p.PolicyType = aws.String("TargetTrackingScaling")
+	p.AdjustmentType = aws.String("ChangeInCapacity")
+	// the metric specifications are stored as json
+	p.TargetTrackingConfiguration = &types.TargetTrackingConfiguration{
+		TargetValue:    aws.Float64(50),
+		DisableScaleIn: aws.Bool(false),
+		PredefinedMetricSpecification: &types.PredefinedMetricSpecification{
+			PredefinedMetricType: types.MetricTypeASGAverageCPUUtilization,
+			ResourceLabel:        aws.String("app/test/1f0b0a6e7b444b55/targetgroup/test/6d9e4a1c8e21"),
+		},
+		CustomizedMetricSpecification: &types.CustomizedMetricSpecification{
+			MetricName: aws.String("RequestCount"),
+			Namespace:  aws.String("AWS/ApplicationELB"),
+			Statistic:  types.MetricStatisticSum,
+			Dimensions: []types.MetricDimension{{Name: aws.String("LoadBalancer"), Value: aws.String("app/test/1f0b0a6e7b444b55")}},
+			Unit:       aws.String("Count"),
+		},
+	}
+	for i := range p.Alarms {
+		p.Alarms[i].AlarmName = aws.String("TargetTracking-test-group-AlarmHigh")
+		p.Alarms[i].AlarmARN = aws.String("arn:aws:cloudwatch:us-east-1:123456789012:alarm:TargetTracking-test-group-AlarmHigh")
+	}
+
+	m.EXPECT().DescribePolicies(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&autoscaling.DescribePoliciesOutput{
+			ScalingPolicies: []types.ScalingPolicy{p},
+		}, nil)
+
+	return client.Services{
+		Autoscaling: m,
+	}
+}
+
+func TestAutoscalingScalingPolicies(t *testing.T) {
+	awsTestHelper(t, AutoscalingScalingPolicies(), buildAutoscalingScalingPolicies)
+}
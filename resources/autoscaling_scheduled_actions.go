@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func AutoscalingScheduledActions() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_autoscaling_scheduled_actions",
+		Resolver:     fetchAutoscalingScheduledActions,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "auto_scaling_group_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "desired_capacity",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "end_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "max_size",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "min_size",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "recurrence",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("ScheduledActionARN"),
+			},
+			{
+				Name: "scheduled_action_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "start_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "time_zone",
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchAutoscalingScheduledActions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config autoscaling.DescribeScheduledActionsInput
+	c := meta.(*client.Client)
+	svc := c.Services().Autoscaling
+	for {
+		output, err := svc.DescribeScheduledActions(ctx, &config, func(o *autoscaling.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.ScheduledUpdateGroupActions
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildAutoscalingScheduledActions(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockAutoscalingClient(ctrl)
+
+	a := types.ScheduledUpdateGroupAction{}
+	err := faker.FakeData(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.AutoScalingGroupName = aws.String("test-group")
+	a.ScheduledActionName = aws.String("scale-up-weekdays")
+	a.ScheduledActionARN = aws.String("arn:aws:autoscaling:us-east-1:123456789012:scheduledUpdateGroupAction:8c2d1f0b-7b44-4b55-0a6e-6d9e4a1c8e21:autoScalingGroupName/test-group:scheduledActionName/scale-up-weekdays")
+	a.Recurrence = aws.String("0 8 * * 1-5")
+	a.TimeZone = aws.String("Europe/Berlin")
+	start := time.Date(2021, 10, 4, 8, 0, 0, 0, time.UTC)
+	a.StartTime = aws.Time(start)
+	a.Time = aws.Time(start)
+	a.EndTime = aws.Time(start.AddDate(1, 0, 0))
+
+	m.EXPECT().DescribeScheduledActions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&autoscaling.DescribeScheduledActionsOutput{
+			ScheduledUpdateGroupActions: []types.ScheduledUpdateGroupAction{a},
+		}, nil)
+
+	return client.Services{
+		Autoscaling: m,
+	}
+}
+
+func TestAutoscalingScheduledActions(t *testing.T) {
+	awsTestHelper(t, AutoscalingScheduledActions(), buildAutoscalingScheduledActions)
+}
@@ -13,7 +13,7 @@ func CloudfrontCachePolicies() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_cloudfront_cache_policies",
 		Resolver:     fetchCloudfrontCachePolicies,
-		Multiplex:    client.AccountRegionMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -22,6 +22,11 @@ func CloudfrontCachePolicies() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name:     "min_ttl",
 				Type:     schema.TypeBigInt,
@@ -114,9 +119,7 @@ func fetchCloudfrontCachePolicies(ctx context.Context, meta schema.ClientMeta, p
 	s := c.Services()
 	svc := s.Cloudfront
 	for {
-		response, err := svc.ListCachePolicies(ctx, nil, func(options *cloudfront.Options) {
-			options.Region = c.Region
-		})
+		response, err := svc.ListCachePolicies(ctx, &config)
 		if err != nil {
 			return err
 		}
@@ -15,9 +15,9 @@ func CloudfrontDistributions() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_cloudfront_distributions",
 		Resolver:     fetchCloudfrontDistributions,
-		Multiplex:    client.AccountRegionMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -280,7 +280,7 @@ func CloudfrontDistributions() *schema.Table {
 				},
 			},
 			{
-				Name:     "cache_behaviour_lambda_function_associations",
+				Name:     "aws_cloudfront_distribution_cache_behaviour_lambda_function_associations",
 				Resolver: fetchCloudfrontDistributionDefaultCacheBehaviourLambdaFunctionAssociations,
 				Columns: []schema.Column{
 					{
@@ -474,9 +474,7 @@ func fetchCloudfrontDistributions(ctx context.Context, meta schema.ClientMeta, _
 	c := meta.(*client.Client)
 	svc := c.Services().Cloudfront
 	for {
-		response, err := svc.ListDistributions(ctx, nil, func(options *cloudfront.Options) {
-			options.Region = c.Region
-		})
+		response, err := svc.ListDistributions(ctx, &config)
 		if err != nil {
 			return err
 		}
@@ -485,10 +483,10 @@ func fetchCloudfrontDistributions(ctx context.Context, meta schema.ClientMeta, _
 			res <- response.DistributionList.Items
 		}
 
-		if aws.ToString(response.DistributionList.Marker) == "" {
+		if aws.ToString(response.DistributionList.NextMarker) == "" {
 			break
 		}
-		config.Marker = response.DistributionList.Marker
+		config.Marker = response.DistributionList.NextMarker
 	}
 	return nil
 }
@@ -200,25 +200,16 @@ func CloudtrailTrails() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchCloudtrailTrails(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	c := meta.(*client.Client)
-	svc := c.Services().Cloudtrail
-	response, err := svc.DescribeTrails(ctx, nil, func(options *cloudtrail.Options) {
-		options.Region = c.Region
-	})
+	trails, err := listCloudtrailTrails(ctx, meta.(*client.Client))
 	if err != nil {
 		return err
 	}
-	res <- response.TrailList
+	res <- trails
 	return nil
 }
 func postCloudtrailTrailResolver(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
-	c := meta.(*client.Client)
-	svc := c.Services().Cloudtrail
 	r := resource.Item.(types.Trail)
-	response, err := svc.GetTrailStatus(ctx,
-		&cloudtrail.GetTrailStatusInput{Name: r.TrailARN}, func(o *cloudtrail.Options) {
-			o.Region = c.Region
-		})
+	response, err := getCloudtrailTrailStatus(ctx, meta.(*client.Client), r.TrailARN)
 	if err != nil {
 		return err
 	}
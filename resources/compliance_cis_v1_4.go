@@ -0,0 +1,571 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudtrailtypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/smithy-go"
+	"github.com/cloudquery/cq-provider-aws/client"
+)
+
+const cisV14Benchmark = "cis_v1.4"
+
+const cisAccessKeyMaxAge = 90 * 24 * time.Hour
+
+// cisAdminPorts are the remote server administration ports checked by control 5.2
+var cisAdminPorts = []int32{22, 3389}
+
+var cisV14Controls = []complianceControl{
+	{
+		ID:       "1.4",
+		Title:    "Ensure no 'root' user account access key exists",
+		Evaluate: evaluateCisRootAccessKeys,
+	},
+	{
+		ID:       "1.5",
+		Title:    "Ensure MFA is enabled for the 'root' user account",
+		Evaluate: evaluateCisRootMfa,
+	},
+	{
+		ID:       "1.8",
+		Title:    "Ensure IAM password policy requires minimum length of 14 or greater",
+		Evaluate: evaluateCisPasswordMinimumLength,
+	},
+	{
+		ID:       "1.9",
+		Title:    "Ensure IAM password policy prevents password reuse",
+		Evaluate: evaluateCisPasswordReusePrevention,
+	},
+	{
+		ID:       "1.10",
+		Title:    "Ensure multi-factor authentication (MFA) is enabled for all IAM users that have a console password",
+		Evaluate: evaluateCisUsersMfa,
+	},
+	{
+		ID:       "1.14",
+		Title:    "Ensure access keys are rotated every 90 days or less",
+		Evaluate: evaluateCisAccessKeysRotated,
+	},
+	{
+		ID:       "2.1.5",
+		Title:    "Ensure that S3 Buckets are configured with 'Block public access (bucket settings)'",
+		Evaluate: evaluateCisS3BucketsBlockPublicAccess,
+	},
+	{
+		ID:       "2.2.1",
+		Title:    "Ensure EBS volume encryption is enabled",
+		Evaluate: evaluateCisEbsEncryptionByDefault,
+	},
+	{
+		ID:       "3.1",
+		Title:    "Ensure CloudTrail is enabled in all regions",
+		Evaluate: evaluateCisCloudtrailMultiRegion,
+	},
+	{
+		ID:       "3.2",
+		Title:    "Ensure CloudTrail log file validation is enabled",
+		Evaluate: evaluateCisCloudtrailLogFileValidation,
+	},
+	{
+		ID:       "3.5",
+		Title:    "Ensure AWS Config is enabled in all regions",
+		Evaluate: evaluateCisConfigEnabled,
+	},
+	{
+		ID:       "3.7",
+		Title:    "Ensure CloudTrail logs are encrypted at rest using KMS CMKs",
+		Evaluate: evaluateCisCloudtrailKmsEncryption,
+	},
+	{
+		ID:       "3.8",
+		Title:    "Ensure rotation for customer created CMKs is enabled",
+		Evaluate: evaluateCisKmsRotation,
+	},
+	{
+		ID:       "3.9",
+		Title:    "Ensure VPC flow logging is enabled in all VPCs",
+		Evaluate: evaluateCisVpcFlowLogs,
+	},
+	{
+		ID:       "5.2",
+		Title:    "Ensure no security groups allow ingress from 0.0.0.0/0 to remote server administration ports",
+		Evaluate: evaluateCisSecurityGroupAdminPorts,
+	},
+	{
+		ID:       "5.3",
+		Title:    "Ensure the default security group of every VPC restricts all traffic",
+		Evaluate: evaluateCisDefaultSecurityGroups,
+	},
+}
+
+func evaluateCisRootAccessKeys(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	report, err := e.credentialReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	root := report.GetUser(accountARN(e.client))
+	if root == nil {
+		return nil, fmt.Errorf("root user not found in credential report")
+	}
+	return []complianceResult{{
+		ResourceArn: root.ARN,
+		Status:      complianceStatus(!root.AccessKey1Active && !root.AccessKey2Active),
+		Evidence: map[string]interface{}{
+			"access_key_1_active": root.AccessKey1Active,
+			"access_key_2_active": root.AccessKey2Active,
+		},
+	}}, nil
+}
+
+func evaluateCisRootMfa(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	report, err := e.credentialReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	root := report.GetUser(accountARN(e.client))
+	if root == nil {
+		return nil, fmt.Errorf("root user not found in credential report")
+	}
+	return []complianceResult{{
+		ResourceArn: root.ARN,
+		Status:      complianceStatus(root.MfaActive),
+		Evidence:    map[string]interface{}{"mfa_active": root.MfaActive},
+	}}, nil
+}
+
+func evaluateCisPasswordMinimumLength(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	return evaluateCisPasswordPolicy(ctx, e, func(minimumLength, reusePrevention int32) (bool, map[string]interface{}) {
+		return minimumLength >= 14, map[string]interface{}{"minimum_password_length": minimumLength}
+	})
+}
+
+func evaluateCisPasswordReusePrevention(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	return evaluateCisPasswordPolicy(ctx, e, func(minimumLength, reusePrevention int32) (bool, map[string]interface{}) {
+		return reusePrevention >= 24, map[string]interface{}{"password_reuse_prevention": reusePrevention}
+	})
+}
+
+func evaluateCisPasswordPolicy(ctx context.Context, e *complianceEvaluation, check func(minimumLength, reusePrevention int32) (bool, map[string]interface{})) ([]complianceResult, error) {
+	output, err := getIamPasswordPolicy(ctx, e.client)
+	if err != nil {
+		var ae smithy.APIError
+		if errors.As(err, &ae) && ae.ErrorCode() == "NoSuchEntity" {
+			return []complianceResult{{
+				ResourceArn: accountARN(e.client),
+				Status:      complianceStatusFail,
+				Evidence:    map[string]interface{}{"password_policy": nil},
+			}}, nil
+		}
+		return nil, err
+	}
+	var minimumLength, reusePrevention int32
+	if output.PasswordPolicy.MinimumPasswordLength != nil {
+		minimumLength = *output.PasswordPolicy.MinimumPasswordLength
+	}
+	if output.PasswordPolicy.PasswordReusePrevention != nil {
+		reusePrevention = *output.PasswordPolicy.PasswordReusePrevention
+	}
+	passed, evidence := check(minimumLength, reusePrevention)
+	return []complianceResult{{
+		ResourceArn: accountARN(e.client),
+		Status:      complianceStatus(passed),
+		Evidence:    evidence,
+	}}, nil
+}
+
+func evaluateCisUsersMfa(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	report, err := e.credentialReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var results []complianceResult
+	for _, u := range report {
+		if u.ARN == accountARN(e.client) || u.PasswordStatus != "true" {
+			continue
+		}
+		results = append(results, complianceResult{
+			ResourceArn: u.ARN,
+			Status:      complianceStatus(u.MfaActive),
+			Evidence: map[string]interface{}{
+				"password_enabled": u.PasswordStatus,
+				"mfa_active":       u.MfaActive,
+			},
+		})
+	}
+	return results, nil
+}
+
+func evaluateCisAccessKeysRotated(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	report, err := e.credentialReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var results []complianceResult
+	for _, u := range report {
+		keys := []struct {
+			active      bool
+			lastRotated string
+			name        string
+		}{
+			{u.AccessKey1Active, u.AccessKey1LastRotated, "access_key_1_last_rotated"},
+			{u.AccessKey2Active, u.AccessKey2LastRotated, "access_key_2_last_rotated"},
+		}
+		for _, k := range keys {
+			if !k.active {
+				continue
+			}
+			rotated, err := time.Parse(time.RFC3339, k.lastRotated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s of %s: %w", k.name, u.ARN, err)
+			}
+			results = append(results, complianceResult{
+				ResourceArn: u.ARN,
+				Status:      complianceStatus(time.Since(rotated) <= cisAccessKeyMaxAge),
+				Evidence:    map[string]interface{}{k.name: k.lastRotated},
+			})
+		}
+	}
+	return results, nil
+}
+
+func evaluateCisS3BucketsBlockPublicAccess(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	buckets, err := listS3Buckets(ctx, e.client)
+	if err != nil {
+		return nil, err
+	}
+	var ae smithy.APIError
+	var results []complianceResult
+	for _, b := range buckets {
+		bucketRegion, err := getS3BucketRegion(ctx, e.client, *b.Name)
+		if err != nil {
+			if errors.As(err, &ae) && ae.ErrorCode() == "NoSuchBucket" {
+				continue
+			}
+			return nil, err
+		}
+		evidence := map[string]interface{}{}
+		cfg, err := getS3PublicAccessBlock(ctx, e.client, *b.Name, bucketRegion)
+		if err != nil {
+			if !(errors.As(err, &ae) && ae.ErrorCode() == "NoSuchPublicAccessBlockConfiguration") {
+				return nil, err
+			}
+		}
+		passed := false
+		if cfg != nil {
+			evidence["block_public_acls"] = cfg.BlockPublicAcls
+			evidence["block_public_policy"] = cfg.BlockPublicPolicy
+			evidence["ignore_public_acls"] = cfg.IgnorePublicAcls
+			evidence["restrict_public_buckets"] = cfg.RestrictPublicBuckets
+			passed = cfg.BlockPublicAcls && cfg.BlockPublicPolicy && cfg.IgnorePublicAcls && cfg.RestrictPublicBuckets
+		}
+		results = append(results, complianceResult{
+			ResourceRegion: bucketRegion,
+			ResourceArn:    fmt.Sprintf("arn:%s:s3:::%s", e.client.Partition(), *b.Name),
+			Status:         complianceStatus(passed),
+			Evidence:       evidence,
+		})
+	}
+	return results, nil
+}
+
+func evaluateCisEbsEncryptionByDefault(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	for _, region := range e.client.Regions() {
+		enabled, err := getEc2EbsEncryptionByDefault(ctx, e.client.WithRegion(region))
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, complianceResult{
+			ResourceRegion: region,
+			ResourceArn:    accountARN(e.client),
+			Status:         complianceStatus(enabled),
+			Evidence:       map[string]interface{}{"ebs_encryption_by_default": enabled},
+		})
+	}
+	return results, nil
+}
+
+func evaluateCisCloudtrailMultiRegion(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	trails, err := cisListTrails(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range trails {
+		if !aws.ToBool(t.trail.IsMultiRegionTrail) {
+			continue
+		}
+		status, err := getCloudtrailTrailStatus(ctx, e.client.WithRegion(t.region), t.trail.TrailARN)
+		if err != nil {
+			return nil, err
+		}
+		if aws.ToBool(status.IsLogging) {
+			return []complianceResult{{
+				ResourceRegion: t.region,
+				ResourceArn:    aws.ToString(t.trail.TrailARN),
+				Status:         complianceStatusPass,
+				Evidence: map[string]interface{}{
+					"is_multi_region_trail": true,
+					"is_logging":            true,
+				},
+			}}, nil
+		}
+	}
+	return []complianceResult{{
+		ResourceArn: accountARN(e.client),
+		Status:      complianceStatusFail,
+		Evidence:    map[string]interface{}{"logging_multi_region_trails": 0},
+	}}, nil
+}
+
+func evaluateCisCloudtrailLogFileValidation(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	trails, err := cisListTrails(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]complianceResult, len(trails))
+	for i, t := range trails {
+		enabled := aws.ToBool(t.trail.LogFileValidationEnabled)
+		results[i] = complianceResult{
+			ResourceRegion: t.region,
+			ResourceArn:    aws.ToString(t.trail.TrailARN),
+			Status:         complianceStatus(enabled),
+			Evidence:       map[string]interface{}{"log_file_validation_enabled": enabled},
+		}
+	}
+	return results, nil
+}
+
+func evaluateCisCloudtrailKmsEncryption(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	trails, err := cisListTrails(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]complianceResult, len(trails))
+	for i, t := range trails {
+		results[i] = complianceResult{
+			ResourceRegion: t.region,
+			ResourceArn:    aws.ToString(t.trail.TrailARN),
+			Status:         complianceStatus(aws.ToString(t.trail.KmsKeyId) != ""),
+			Evidence:       map[string]interface{}{"kms_key_id": t.trail.KmsKeyId},
+		}
+	}
+	return results, nil
+}
+
+type cisTrail struct {
+	region string
+	trail  cloudtrailtypes.Trail
+}
+
+// cisListTrails lists the trails of every region, skipping shadow trails of multi region trails
+// so each trail is evaluated once, in its home region.
+func cisListTrails(ctx context.Context, e *complianceEvaluation) ([]cisTrail, error) {
+	var trails []cisTrail
+	for _, region := range e.client.Regions() {
+		list, err := listCloudtrailTrails(ctx, e.client.WithRegion(region))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range list {
+			if t.HomeRegion != nil && *t.HomeRegion != region {
+				continue
+			}
+			trails = append(trails, cisTrail{region: region, trail: t})
+		}
+	}
+	return trails, nil
+}
+
+func evaluateCisConfigEnabled(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	for _, region := range e.client.Regions() {
+		c := e.client.WithRegion(region)
+		recorders, err := listConfigConfigurationRecorders(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		statuses, err := listConfigConfigurationRecorderStatuses(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		recording := make(map[string]bool, len(statuses))
+		for _, s := range statuses {
+			recording[aws.ToString(s.Name)] = s.Recording
+		}
+		passed := false
+		evidence := map[string]interface{}{"configuration_recorders": len(recorders)}
+		for _, r := range recorders {
+			if r.RecordingGroup == nil {
+				continue
+			}
+			if r.RecordingGroup.AllSupported && r.RecordingGroup.IncludeGlobalResourceTypes && recording[aws.ToString(r.Name)] {
+				passed = true
+				evidence["name"] = r.Name
+				evidence["all_supported"] = true
+				evidence["include_global_resource_types"] = true
+				evidence["recording"] = true
+				break
+			}
+		}
+		results = append(results, complianceResult{
+			ResourceRegion: region,
+			ResourceArn:    accountARN(e.client),
+			Status:         complianceStatus(passed),
+			Evidence:       evidence,
+		})
+	}
+	return results, nil
+}
+
+func evaluateCisKmsRotation(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	for _, region := range e.client.Regions() {
+		c := e.client.WithRegion(region)
+		keys, err := listKmsKeys(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			metadata, err := describeKmsKey(ctx, c, k.KeyId)
+			if err != nil {
+				return nil, err
+			}
+			// rotation only applies to enabled, symmetric, customer managed keys with AWS provided key material
+			if metadata == nil || metadata.KeyManager != kmstypes.KeyManagerTypeCustomer || !metadata.Enabled ||
+				metadata.CustomerMasterKeySpec != kmstypes.CustomerMasterKeySpecSymmetricDefault || metadata.Origin != kmstypes.OriginTypeAwsKms {
+				continue
+			}
+			rotationEnabled, err := getKmsKeyRotationStatus(ctx, c, k.KeyId)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, complianceResult{
+				ResourceRegion: region,
+				ResourceArn:    aws.ToString(k.KeyArn),
+				Status:         complianceStatus(rotationEnabled),
+				Evidence:       map[string]interface{}{"rotation_enabled": rotationEnabled},
+			})
+		}
+	}
+	return results, nil
+}
+
+func evaluateCisVpcFlowLogs(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	for _, region := range e.client.Regions() {
+		c := e.client.WithRegion(region)
+		list, err := listEc2FlowLogs(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		flowLogs := make(map[string]string, len(list))
+		for _, f := range list {
+			flowLogs[aws.ToString(f.ResourceId)] = aws.ToString(f.FlowLogId)
+		}
+		vpcs, err := listEc2Vpcs(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vpcs {
+			flowLogID, ok := flowLogs[aws.ToString(v.VpcId)]
+			evidence := map[string]interface{}{"flow_log_id": nil}
+			if ok {
+				evidence["flow_log_id"] = flowLogID
+			}
+			results = append(results, complianceResult{
+				ResourceRegion: region,
+				ResourceArn:    client.GenerateResourceARN("ec2", "vpc", aws.ToString(v.VpcId), region, e.client.AccountID),
+				Status:         complianceStatus(ok),
+				Evidence:       evidence,
+			})
+		}
+	}
+	return results, nil
+}
+
+func evaluateCisSecurityGroupAdminPorts(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	err := cisForEachSecurityGroup(ctx, e, func(region string, sg ec2types.SecurityGroup) {
+		var openPorts []int32
+		for _, p := range sg.IpPermissions {
+			if !ipPermissionOpenToWorld(p) {
+				continue
+			}
+			for _, port := range cisAdminPorts {
+				if ipPermissionIncludesPort(p, port) {
+					openPorts = append(openPorts, port)
+				}
+			}
+		}
+		results = append(results, complianceResult{
+			ResourceRegion: region,
+			ResourceArn:    client.GenerateResourceARN("ec2", "security-group", aws.ToString(sg.GroupId), region, e.client.AccountID),
+			Status:         complianceStatus(len(openPorts) == 0),
+			Evidence:       map[string]interface{}{"open_admin_ports": openPorts},
+		})
+	})
+	return results, err
+}
+
+func evaluateCisDefaultSecurityGroups(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error) {
+	var results []complianceResult
+	err := cisForEachSecurityGroup(ctx, e, func(region string, sg ec2types.SecurityGroup) {
+		if aws.ToString(sg.GroupName) != "default" {
+			return
+		}
+		results = append(results, complianceResult{
+			ResourceRegion: region,
+			ResourceArn:    client.GenerateResourceARN("ec2", "security-group", aws.ToString(sg.GroupId), region, e.client.AccountID),
+			Status:         complianceStatus(len(sg.IpPermissions) == 0 && len(sg.IpPermissionsEgress) == 0),
+			Evidence: map[string]interface{}{
+				"ingress_rules": len(sg.IpPermissions),
+				"egress_rules":  len(sg.IpPermissionsEgress),
+			},
+		})
+	})
+	return results, err
+}
+
+func cisForEachSecurityGroup(ctx context.Context, e *complianceEvaluation, f func(region string, sg ec2types.SecurityGroup)) error {
+	for _, region := range e.client.Regions() {
+		groups, err := listEc2SecurityGroups(ctx, e.client.WithRegion(region))
+		if err != nil {
+			return err
+		}
+		for _, sg := range groups {
+			f(region, sg)
+		}
+	}
+	return nil
+}
+
+func ipPermissionOpenToWorld(p ec2types.IpPermission) bool {
+	for _, r := range p.IpRanges {
+		if aws.ToString(r.CidrIp) == "0.0.0.0/0" {
+			return true
+		}
+	}
+	for _, r := range p.Ipv6Ranges {
+		if aws.ToString(r.CidrIpv6) == "::/0" {
+			return true
+		}
+	}
+	return false
+}
+
+func ipPermissionIncludesPort(p ec2types.IpPermission, port int32) bool {
+	// protocol -1 means all protocols and ports
+	if aws.ToString(p.IpProtocol) == "-1" {
+		return true
+	}
+	if aws.ToString(p.IpProtocol) != "tcp" && aws.ToString(p.IpProtocol) != "6" {
+		return false
+	}
+	return p.FromPort <= port && port <= p.ToPort
+}
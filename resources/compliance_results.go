@@ -0,0 +1,139 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const (
+	complianceStatusPass  = "pass"
+	complianceStatusFail  = "fail"
+	complianceStatusError = "error"
+)
+
+// complianceControl is a single benchmark control evaluated in Go against the account.
+type complianceControl struct {
+	ID       string
+	Title    string
+	Evaluate func(ctx context.Context, e *complianceEvaluation) ([]complianceResult, error)
+}
+
+type complianceResult struct {
+	Benchmark      string
+	ControlId      string
+	ControlTitle   string
+	ResourceRegion string
+	ResourceArn    string
+	Status         string
+	Evidence       map[string]interface{}
+}
+
+// complianceEvaluation holds the state shared between the controls of a single account evaluation.
+type complianceEvaluation struct {
+	client *client.Client
+	report reportUsers
+}
+
+func (e *complianceEvaluation) credentialReport(ctx context.Context) (reportUsers, error) {
+	if e.report != nil {
+		return e.report, nil
+	}
+	report, err := listCredentialReport(ctx, e.client)
+	if err != nil {
+		return nil, err
+	}
+	e.report = report
+	return report, nil
+}
+
+func ComplianceResults() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_compliance_results",
+		Description:  "Results of the built-in compliance benchmarks, evaluated per account after fetching the relevant configuration",
+		Resolver:     fetchComplianceResults,
+		Multiplex:    client.AccountMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:        "benchmark",
+				Type:        schema.TypeString,
+				Description: "The benchmark the control belongs to, i.e cis_v1.4",
+			},
+			{
+				Name: "control_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "control_title",
+				Type: schema.TypeString,
+			},
+			{
+				Name:        "resource_region",
+				Type:        schema.TypeString,
+				Description: "The region of the evaluated resource, empty for account wide and global resources",
+			},
+			{
+				Name: "resource_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Type:        schema.TypeString,
+				Description: "The evaluation status, one of pass, fail or error",
+			},
+			{
+				Name:        "evidence",
+				Type:        schema.TypeJSON,
+				Description: "The configuration values the status was decided on",
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchComplianceResults(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	e := &complianceEvaluation{client: c}
+	for _, control := range cisV14Controls {
+		results, err := control.Evaluate(ctx, e)
+		if err != nil {
+			// a control that can't be evaluated shouldn't fail the whole audit
+			c.Logger().Warn("failed to evaluate compliance control", "benchmark", cisV14Benchmark, "control", control.ID, "error", err)
+			results = []complianceResult{{
+				ResourceArn: accountARN(c),
+				Status:      complianceStatusError,
+				Evidence:    map[string]interface{}{"error": err.Error()},
+			}}
+		}
+		for i := range results {
+			results[i].Benchmark = cisV14Benchmark
+			results[i].ControlId = control.ID
+			results[i].ControlTitle = control.Title
+		}
+		res <- results
+	}
+	return nil
+}
+
+// accountARN returns the arn of the root user of the client's account
+func accountARN(c *client.Client) string {
+	return fmt.Sprintf("arn:%s:iam::%s:root", c.Partition(), c.AccountID)
+}
+
+func complianceStatus(passed bool) string {
+	if passed {
+		return complianceStatusPass
+	}
+	return complianceStatusFail
+}
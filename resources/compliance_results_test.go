@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cloudtrailTypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	configTypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamTypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmsTypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/gocarina/gocsv"
+	"github.com/golang/mock/gomock"
+)
+
+func buildComplianceResults(t *testing.T, ctrl *gomock.Controller) client.Services {
+	iamMock := mocks.NewMockIamClient(ctrl)
+	s3Mock := mocks.NewMockS3Client(ctrl)
+	s3ManagerMock := mocks.NewMockS3ManagerClient(ctrl)
+	ec2Mock := mocks.NewMockEc2Client(ctrl)
+	cloudtrailMock := mocks.NewMockCloudtrailClient(ctrl)
+	configMock := mocks.NewMockConfigServiceClient(ctrl)
+	kmsMock := mocks.NewMockKmsClient(ctrl)
+
+	now := time.Now().Format(time.RFC3339)
+	content, err := gocsv.MarshalBytes([]reportUser{
+		{
+			User:                  "<root_account>",
+			ARN:                   "arn:aws:iam::testAccount:root",
+			PasswordStatus:        "not_supported",
+			MfaActive:             true,
+			AccessKey1LastRotated: "N/A",
+			AccessKey2LastRotated: "N/A",
+		},
+		{
+			User:                  "user",
+			ARN:                   "arn:aws:iam::testAccount:user/user",
+			PasswordStatus:        "true",
+			AccessKey1Active:      true,
+			AccessKey1LastRotated: now,
+			AccessKey2LastRotated: "N/A",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	iamMock.EXPECT().GetCredentialReport(gomock.Any(), gomock.Any()).Return(
+		&iam.GetCredentialReportOutput{Content: content}, nil)
+	iamMock.EXPECT().GetAccountPasswordPolicy(gomock.Any(), gomock.Any()).Return(
+		&iam.GetAccountPasswordPolicyOutput{PasswordPolicy: &iamTypes.PasswordPolicy{
+			MinimumPasswordLength:   aws.Int32(14),
+			PasswordReusePrevention: aws.Int32(5),
+		}}, nil)
+
+	s3Mock.EXPECT().ListBuckets(gomock.Any(), gomock.Any()).Return(
+		&s3.ListBucketsOutput{Buckets: []s3Types.Bucket{{Name: aws.String("bucket")}}}, nil)
+	s3ManagerMock.EXPECT().GetBucketRegion(gomock.Any(), gomock.Any(), gomock.Any()).Return("us-east-1", nil)
+	s3Mock.EXPECT().GetPublicAccessBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&s3.GetPublicAccessBlockOutput{PublicAccessBlockConfiguration: &s3Types.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       true,
+			BlockPublicPolicy:     true,
+			IgnorePublicAcls:      true,
+			RestrictPublicBuckets: true,
+		}}, nil)
+
+	ec2Mock.EXPECT().GetEbsEncryptionByDefault(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.GetEbsEncryptionByDefaultOutput{EbsEncryptionByDefault: true}, nil)
+	ec2Mock.EXPECT().DescribeFlowLogs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeFlowLogsOutput{FlowLogs: []ec2Types.FlowLog{{FlowLogId: aws.String("fl-1"), ResourceId: aws.String("vpc-1")}}}, nil)
+	ec2Mock.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcsOutput{Vpcs: []ec2Types.Vpc{{VpcId: aws.String("vpc-1")}}}, nil)
+	ec2Mock.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSecurityGroupsOutput{SecurityGroups: []ec2Types.SecurityGroup{
+			{
+				GroupId:   aws.String("sg-1"),
+				GroupName: aws.String("default"),
+			},
+			{
+				GroupId:   aws.String("sg-2"),
+				GroupName: aws.String("ssh"),
+				IpPermissions: []ec2Types.IpPermission{{
+					IpProtocol: aws.String("tcp"),
+					FromPort:   22,
+					ToPort:     22,
+					IpRanges:   []ec2Types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				}},
+			},
+		}}, nil)
+
+	trail := cloudtrailTypes.Trail{
+		TrailARN:                 aws.String("arn:aws:cloudtrail:us-east-1:testAccount:trail/trail"),
+		HomeRegion:               aws.String("us-east-1"),
+		IsMultiRegionTrail:       aws.Bool(true),
+		LogFileValidationEnabled: aws.Bool(true),
+		KmsKeyId:                 aws.String("key"),
+	}
+	cloudtrailMock.EXPECT().DescribeTrails(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudtrail.DescribeTrailsOutput{TrailList: []cloudtrailTypes.Trail{trail}}, nil)
+	cloudtrailMock.EXPECT().GetTrailStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudtrail.GetTrailStatusOutput{IsLogging: aws.Bool(true)}, nil)
+
+	configMock.EXPECT().DescribeConfigurationRecorders(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&configservice.DescribeConfigurationRecordersOutput{ConfigurationRecorders: []configTypes.ConfigurationRecorder{{
+			Name: aws.String("default"),
+			RecordingGroup: &configTypes.RecordingGroup{
+				AllSupported:               true,
+				IncludeGlobalResourceTypes: true,
+			},
+		}}}, nil)
+	configMock.EXPECT().DescribeConfigurationRecorderStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&configservice.DescribeConfigurationRecorderStatusOutput{ConfigurationRecordersStatus: []configTypes.ConfigurationRecorderStatus{{
+			Name:      aws.String("default"),
+			Recording: true,
+		}}}, nil)
+
+	kmsMock.EXPECT().ListKeys(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&kms.ListKeysOutput{Keys: []kmsTypes.KeyListEntry{{KeyId: aws.String("key"), KeyArn: aws.String("arn:aws:kms:us-east-1:testAccount:key/key")}}}, nil)
+	kmsMock.EXPECT().DescribeKey(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&kms.DescribeKeyOutput{KeyMetadata: &kmsTypes.KeyMetadata{
+			KeyId:                 aws.String("key"),
+			Enabled:               true,
+			KeyManager:            kmsTypes.KeyManagerTypeCustomer,
+			CustomerMasterKeySpec: kmsTypes.CustomerMasterKeySpecSymmetricDefault,
+			Origin:                kmsTypes.OriginTypeAwsKms,
+		}}, nil)
+	kmsMock.EXPECT().GetKeyRotationStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&kms.GetKeyRotationStatusOutput{KeyRotationEnabled: true}, nil)
+
+	return client.Services{
+		IAM:           iamMock,
+		S3:            s3Mock,
+		S3Manager:     s3ManagerMock,
+		EC2:           ec2Mock,
+		Cloudtrail:    cloudtrailMock,
+		ConfigService: configMock,
+		KMS:           kmsMock,
+	}
+}
+
+func TestComplianceResults(t *testing.T) {
+	awsTestHelper(t, ComplianceResults(), buildComplianceResults)
+}
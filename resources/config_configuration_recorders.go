@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/service/configservice"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 )
@@ -58,13 +57,10 @@ func ConfigConfigurationRecorders() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchConfigConfigurationRecorders(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	c := meta.(*client.Client)
-	resp, err := c.Services().ConfigService.DescribeConfigurationRecorders(ctx, &configservice.DescribeConfigurationRecordersInput{}, func(options *configservice.Options) {
-		options.Region = c.Region
-	})
+	recorders, err := listConfigConfigurationRecorders(ctx, meta.(*client.Client))
 	if err != nil {
 		return err
 	}
-	res <- resp.ConfigurationRecorders
+	res <- recorders
 	return nil
 }
@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2CapacityReservations() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_capacity_reservations",
+		Resolver:     fetchEc2CapacityReservations,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("CapacityReservationArn"),
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zone_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "available_instance_count",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "capacity_reservation_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "create_date",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "ebs_optimized",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "end_date",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "end_date_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "ephemeral_storage",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "instance_match_criteria",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_platform",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "start_date",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2CapacityReservationTags,
+			},
+			{
+				Name: "tenancy",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "total_instance_count",
+				Type: schema.TypeInt,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2CapacityReservations(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeCapacityReservationsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeCapacityReservations(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.CapacityReservations
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2CapacityReservationTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.CapacityReservation)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2CapacityReservations(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.CapacityReservation{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.CapacityReservationId = aws.String("cr-0a1b2c3d4e5f6a7b8")
+	r.CapacityReservationArn = aws.String("arn:aws:ec2:us-east-1:123456789012:capacity-reservation/cr-0a1b2c3d4e5f6a7b8")
+	r.OwnerId = aws.String("123456789012")
+	r.AvailabilityZone = aws.String("us-east-1a")
+	r.AvailabilityZoneId = aws.String("use1-az1")
+	r.InstanceType = aws.String("m5.large")
+	r.InstancePlatform = types.CapacityReservationInstancePlatformLinuxUnix
+	r.Tenancy = types.CapacityReservationTenancyDefault
+	r.State = types.CapacityReservationStateActive
+	r.InstanceMatchCriteria = types.InstanceMatchCriteriaOpen
+	r.EndDateType = types.EndDateTypeLimited
+	r.TotalInstanceCount = 4
+	r.AvailableInstanceCount = 1
+	start := time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)
+	r.CreateDate = aws.Time(start)
+	r.StartDate = aws.Time(start)
+	r.EndDate = aws.Time(start.AddDate(0, 1, 0))
+	r.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeCapacityReservations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeCapacityReservationsOutput{
+			CapacityReservations: []types.CapacityReservation{r},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2CapacityReservations(t *testing.T) {
+	awsTestHelper(t, Ec2CapacityReservations(), buildEc2CapacityReservations)
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2DhcpOptions() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_dhcp_options",
+		Resolver:     fetchEc2DhcpOptions,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2DhcpOptionsArn,
+			},
+			{
+				Name: "dhcp_options_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2DhcpOptionsTags,
+			},
+			{
+				Name:     "domain_name",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2DhcpOptionsConfiguration("domain-name"),
+			},
+			{
+				Name:     "domain_name_servers",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2DhcpOptionsConfigurationValues("domain-name-servers"),
+			},
+			{
+				Name:     "ntp_servers",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2DhcpOptionsConfigurationValues("ntp-servers"),
+			},
+			{
+				Name:     "netbios_name_servers",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2DhcpOptionsConfigurationValues("netbios-name-servers"),
+			},
+			{
+				Name:     "netbios_node_type",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2DhcpOptionsConfiguration("netbios-node-type"),
+			},
+			{
+				Name: "vpc_ids",
+				Type: schema.TypeStringArray,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+// wrappedDhcpOptions holds the VPCs a DHCP option set is associated with
+type wrappedDhcpOptions struct {
+	types.DhcpOptions
+	VpcIds []string
+}
+
+func fetchEc2DhcpOptions(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	// the VPCs are shared with the tables listing them
+	vpcList, err := listEc2Vpcs(ctx, c)
+	if err != nil {
+		return err
+	}
+	vpcs := make(map[string][]string)
+	for _, vpc := range vpcList {
+		id := aws.ToString(vpc.DhcpOptionsId)
+		vpcs[id] = append(vpcs[id], aws.ToString(vpc.VpcId))
+	}
+
+	var config ec2.DescribeDhcpOptionsInput
+	for {
+		output, err := svc.DescribeDhcpOptions(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		options := make([]wrappedDhcpOptions, 0, len(output.DhcpOptions))
+		for _, o := range output.DhcpOptions {
+			options = append(options, wrappedDhcpOptions{DhcpOptions: o, VpcIds: vpcs[aws.ToString(o.DhcpOptionsId)]})
+		}
+		res <- options
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2DhcpOptionsArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(wrappedDhcpOptions)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "dhcp-options", aws.ToString(r.DhcpOptionsId), cl.Region, cl.AccountID))
+}
+func resolveEc2DhcpOptionsTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedDhcpOptions)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+
+// resolveEc2DhcpOptionsConfiguration resolves the first value of the configuration with the given key
+func resolveEc2DhcpOptionsConfiguration(key string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		values := dhcpConfigurationValues(resource.Item.(wrappedDhcpOptions), key)
+		if len(values) == 0 {
+			return nil
+		}
+		return resource.Set(c.Name, values[0])
+	}
+}
+
+// resolveEc2DhcpOptionsConfigurationValues resolves all values of the configuration with the given key
+func resolveEc2DhcpOptionsConfigurationValues(key string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		return resource.Set(c.Name, dhcpConfigurationValues(resource.Item.(wrappedDhcpOptions), key))
+	}
+}
+
+func dhcpConfigurationValues(r wrappedDhcpOptions, key string) []string {
+	var values []string
+	for _, configuration := range r.DhcpConfigurations {
+		if aws.ToString(configuration.Key) != key {
+			continue
+		}
+		for _, v := range configuration.Values {
+			values = append(values, aws.ToString(v.Value))
+		}
+	}
+	return values
+}
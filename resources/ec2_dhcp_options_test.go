@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2DhcpOptions(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	o := types.DhcpOptions{}
+	err := faker.FakeData(&o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.DhcpOptionsId = aws.String("dopt-0a1b2c3d")
+	o.OwnerId = aws.String("123456789012")
+	configuration := func(key string, values ...string) types.DhcpConfiguration {
+		c := types.DhcpConfiguration{Key: aws.String(key)}
+		for _, v := range values {
+			c.Values = append(c.Values, types.AttributeValue{Value: aws.String(v)})
+		}
+		return c
+	}
+	o.DhcpConfigurations = []types.DhcpConfiguration{
+		configuration("domain-name", "ec2.internal"),
+		configuration("domain-name-servers", "10.0.0.2", "10.0.0.3"),
+		configuration("ntp-servers", "169.254.169.123"),
+		configuration("netbios-name-servers", "10.0.0.4"),
+		configuration("netbios-node-type", "2"),
+	}
+	o.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+	m.EXPECT().DescribeDhcpOptions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeDhcpOptionsOutput{
+			DhcpOptions: []types.DhcpOptions{o},
+		}, nil)
+
+	// the VPC associated with the option set
+	v := types.Vpc{}
+	err = faker.FakeData(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.VpcId = aws.String("vpc-0a1b2c3d")
+	v.DhcpOptionsId = o.DhcpOptionsId
+	m.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcsOutput{
+			Vpcs: []types.Vpc{v},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2DhcpOptions(t *testing.T) {
+	awsTestHelper(t, Ec2DhcpOptions(), buildEc2DhcpOptions)
+}
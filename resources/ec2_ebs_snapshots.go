@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2EbsSnapshots() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_ebs_snapshots",
+		Resolver:     fetchEc2EbsSnapshots,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "snapshot_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "data_encryption_key_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "description",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "encrypted",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "kms_key_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "outpost_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "owner_alias",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "progress",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "start_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "state_message",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2EbsSnapshotTags,
+			},
+			{
+				Name: "volume_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "volume_size",
+				Type: schema.TypeInt,
+			},
+			{
+				Name:     "is_public",
+				Type:     schema.TypeBool,
+				Resolver: resolveEc2EbsSnapshotIsPublic,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_ebs_snapshot_create_volume_permissions",
+				Resolver: fetchEc2EbsSnapshotCreateVolumePermissions,
+				Columns: []schema.Column{
+					{
+						Name:     "ebs_snapshot_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "group",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "user_id",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+// wrappedSnapshot holds the accounts and groups a snapshot is shared with, as they're described by a separate call
+type wrappedSnapshot struct {
+	types.Snapshot
+	CreateVolumePermissions []types.CreateVolumePermission
+}
+
+func fetchEc2EbsSnapshots(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	config := ec2.DescribeSnapshotsInput{OwnerIds: []string{"self"}}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeSnapshots(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		snapshots := make([]wrappedSnapshot, 0, len(output.Snapshots))
+		for _, s := range output.Snapshots {
+			attribute, err := svc.DescribeSnapshotAttribute(ctx, &ec2.DescribeSnapshotAttributeInput{
+				Attribute:  types.SnapshotAttributeNameCreateVolumePermission,
+				SnapshotId: s.SnapshotId,
+			}, func(o *ec2.Options) {
+				o.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			snapshots = append(snapshots, wrappedSnapshot{Snapshot: s, CreateVolumePermissions: attribute.CreateVolumePermissions})
+		}
+		res <- snapshots
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2EbsSnapshotTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, _ schema.Column) error {
+	r := resource.Item.(wrappedSnapshot)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set("tags", tags)
+}
+func resolveEc2EbsSnapshotIsPublic(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedSnapshot)
+	for _, p := range r.CreateVolumePermissions {
+		if p.Group == types.PermissionGroupAll {
+			return resource.Set(c.Name, true)
+		}
+	}
+	return resource.Set(c.Name, false)
+}
+func fetchEc2EbsSnapshotCreateVolumePermissions(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	snapshot, ok := parent.Item.(wrappedSnapshot)
+	if !ok {
+		return fmt.Errorf("not ec2 ebs snapshot")
+	}
+	res <- snapshot.CreateVolumePermissions
+	return nil
+}
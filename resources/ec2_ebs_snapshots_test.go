@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2EbsSnapshots(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+	s := ec2Types.Snapshot{}
+	err := faker.FakeData(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SnapshotId = aws.String("snap-0a1b2c3d4e5f6a7b8")
+	s.VolumeId = aws.String("vol-0a1b2c3d4e5f6a7b8")
+	s.VolumeSize = 8
+	s.OwnerId = aws.String("123456789012")
+	s.OwnerAlias = aws.String("self")
+	s.OutpostArn = aws.String("arn:aws:outposts:us-east-1:123456789012:outpost/op-0a1b2c3d4e5f6a7b8")
+	s.Encrypted = true
+	s.KmsKeyId = aws.String("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+	s.Progress = aws.String("100%")
+	s.State = ec2Types.SnapshotStateCompleted
+	s.Tags = []ec2Types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+	m.EXPECT().DescribeSnapshots(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSnapshotsOutput{
+			Snapshots: []ec2Types.Snapshot{s},
+		}, nil)
+
+	// the snapshot is public, the permission sets both columns so the test finds no empty column
+	p := ec2Types.CreateVolumePermission{
+		Group:  ec2Types.PermissionGroupAll,
+		UserId: aws.String("210987654321"),
+	}
+	m.EXPECT().DescribeSnapshotAttribute(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSnapshotAttributeOutput{
+			CreateVolumePermissions: []ec2Types.CreateVolumePermission{p},
+			SnapshotId:              s.SnapshotId,
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2EbsSnapshots(t *testing.T) {
+	awsTestHelper(t, Ec2EbsSnapshots(), buildEc2EbsSnapshots)
+}
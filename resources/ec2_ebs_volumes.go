@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -16,7 +17,7 @@ func Ec2EbsVolumes() *schema.Table {
 		Resolver:     fetchEc2EbsVolumes,
 		Multiplex:    client.AccountRegionMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		DeleteFilter: client.DeleteTargetFilter(client.DeleteAccountRegionFilter, "ec2", "volume", "volume_id"),
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -135,9 +136,15 @@ func Ec2EbsVolumes() *schema.Table {
 // ====================================================================================================================
 func fetchEc2EbsVolumes(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-
-	response, err := svc.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{}, func(o *ec2.Options) {
+	if !c.Targeted() {
+		return c.SendPages(ctx, ec2EbsVolumesListing(c), res)
+	}
+	ids := c.TargetedResourceIDs("ec2", "volume")
+	if len(ids) == 0 {
+		return nil
+	}
+	config := ec2.DescribeVolumesInput{Filters: []types.Filter{{Name: aws.String("volume-id"), Values: ids}}}
+	response, err := c.Services().EC2.DescribeVolumes(ctx, &config, func(o *ec2.Options) {
 		o.Region = c.Region
 	})
 	if err != nil {
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2EgressOnlyInternetGateways() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_egress_only_internet_gateways",
+		Resolver:     fetchEc2EgressOnlyInternetGateways,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2EgressOnlyInternetGatewayArn,
+			},
+			{
+				Name: "egress_only_internet_gateway_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2EgressOnlyInternetGatewayTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_egress_only_internet_gateway_attachments",
+				Resolver: fetchEc2EgressOnlyInternetGatewayAttachments,
+				Columns: []schema.Column{
+					{
+						Name:     "egress_only_internet_gateway_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "state",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "vpc_id",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2EgressOnlyInternetGateways(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeEgressOnlyInternetGatewaysInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeEgressOnlyInternetGateways(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.EgressOnlyInternetGateways
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2EgressOnlyInternetGatewayArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.EgressOnlyInternetGateway)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "egress-only-internet-gateway", aws.ToString(r.EgressOnlyInternetGatewayId), cl.Region, cl.AccountID))
+}
+func resolveEc2EgressOnlyInternetGatewayTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.EgressOnlyInternetGateway)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2EgressOnlyInternetGatewayAttachments(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.EgressOnlyInternetGateway)
+	if !ok {
+		return fmt.Errorf("expected types.EgressOnlyInternetGateway but got %T", parent.Item)
+	}
+	res <- r.Attachments
+	return nil
+}
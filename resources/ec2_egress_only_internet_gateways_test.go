@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2EgressOnlyInternetGateways(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	g := types.EgressOnlyInternetGateway{}
+	err := faker.FakeData(&g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.EgressOnlyInternetGatewayId = aws.String("eigw-0a1b2c3d4e5f6a7b8")
+	g.Attachments = []types.InternetGatewayAttachment{{State: types.AttachmentStatusAttached, VpcId: aws.String("vpc-0a1b2c3d")}}
+	g.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeEgressOnlyInternetGateways(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeEgressOnlyInternetGatewaysOutput{
+			EgressOnlyInternetGateways: []types.EgressOnlyInternetGateway{g},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2EgressOnlyInternetGateways(t *testing.T) {
+	awsTestHelper(t, Ec2EgressOnlyInternetGateways(), buildEc2EgressOnlyInternetGateways)
+}
@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2Eips() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_eips",
+		Resolver:     fetchEc2Eips,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "allocation_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "association_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "carrier_ip",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "customer_owned_ip",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "customer_owned_ipv4_pool",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "domain",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "network_border_group",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "network_interface_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "network_interface_owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "private_ip_address",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "public_ip",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "public_ipv4_pool",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2EipTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2Eips(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeAddressesInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribeAddresses(ctx, &config, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.Addresses
+	return nil
+}
+func resolveEc2EipTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.Address)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
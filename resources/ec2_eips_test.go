@@ -0,0 +1,50 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2Eips(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	a := types.Address{}
+	err := faker.FakeData(&a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.AllocationId = aws.String("eipalloc-0a1b2c3d")
+	a.AssociationId = aws.String("eipassoc-0a1b2c3d")
+	a.Domain = types.DomainTypeVpc
+	a.PublicIp = aws.String("54.0.0.10")
+	a.PublicIpv4Pool = aws.String("amazon")
+	a.NetworkBorderGroup = aws.String("us-east-1")
+	a.InstanceId = aws.String("i-0a1b2c3d4e5f6a7b8")
+	a.NetworkInterfaceId = aws.String("eni-0a1b2c3d4e5f6a7b8")
+	a.NetworkInterfaceOwnerId = aws.String("123456789012")
+	a.PrivateIpAddress = aws.String("10.0.1.10")
+	a.CarrierIp = aws.String("155.146.0.10")
+	a.CustomerOwnedIp = aws.String("192.168.0.10")
+	a.CustomerOwnedIpv4Pool = aws.String("ipv4pool-coip-0a1b2c3d")
+	a.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeAddresses(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeAddressesOutput{
+			Addresses: []types.Address{a},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2Eips(t *testing.T) {
+	awsTestHelper(t, Ec2Eips(), buildEc2Eips)
+}
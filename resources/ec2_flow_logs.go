@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -93,23 +91,8 @@ func Ec2FlowLogs() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchEc2FlowLogs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeFlowLogsInput
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-	for {
-		output, err := svc.DescribeFlowLogs(ctx, &config, func(options *ec2.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- output.FlowLogs
-		if aws.ToString(output.NextToken) == "" {
-			break
-		}
-		config.NextToken = output.NextToken
-	}
-	return nil
+	return c.SendPages(ctx, ec2FlowLogsListing(c), res)
 }
 func resolveEc2flowLogTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.FlowLog)
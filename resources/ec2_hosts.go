@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2Hosts() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_hosts",
+		Resolver:     fetchEc2Hosts,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2HostArn,
+			},
+			{
+				Name: "allocation_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "allows_multiple_instance_types",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "auto_placement",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zone_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "available_vcpus",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("AvailableCapacity.AvailableVCpus"),
+			},
+			{
+				Name: "client_token",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "host_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "cores",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("HostProperties.Cores"),
+			},
+			{
+				Name:     "instance_family",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("HostProperties.InstanceFamily"),
+			},
+			{
+				Name:     "instance_type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("HostProperties.InstanceType"),
+			},
+			{
+				Name:     "sockets",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("HostProperties.Sockets"),
+			},
+			{
+				Name:     "total_vcpus",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("HostProperties.TotalVCpus"),
+			},
+			{
+				Name: "host_recovery",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "host_reservation_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "member_of_service_linked_resource_group",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "release_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2HostTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_host_instances",
+				Resolver: fetchEc2HostInstances,
+				Columns: []schema.Column{
+					{
+						Name:     "host_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "instance_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "instance_type",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "owner_id",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_host_available_instance_capacities",
+				Resolver: fetchEc2HostAvailableInstanceCapacities,
+				Columns: []schema.Column{
+					{
+						Name:     "host_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "available_capacity",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "instance_type",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "total_capacity",
+						Type: schema.TypeInt,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2Hosts(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeHostsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeHosts(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.Hosts
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+
+// resolveEc2HostArn uses the owner of the host, hosts shared with the account are owned by another account
+func resolveEc2HostArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.Host)
+	accountID := aws.ToString(r.OwnerId)
+	if accountID == "" {
+		accountID = cl.AccountID
+	}
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "dedicated-host", aws.ToString(r.HostId), cl.Region, accountID))
+}
+func resolveEc2HostTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.Host)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2HostInstances(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.Host)
+	if !ok {
+		return fmt.Errorf("expected types.Host but got %T", parent.Item)
+	}
+	res <- r.Instances
+	return nil
+}
+func fetchEc2HostAvailableInstanceCapacities(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.Host)
+	if !ok {
+		return fmt.Errorf("expected types.Host but got %T", parent.Item)
+	}
+	if r.AvailableCapacity == nil {
+		return nil
+	}
+	res <- r.AvailableCapacity.AvailableInstanceCapacity
+	return nil
+}
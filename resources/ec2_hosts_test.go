@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2Hosts(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	h := types.Host{}
+	err := faker.FakeData(&h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.HostId = aws.String("h-0a1b2c3d4e5f6a7b8")
+	// the arn is built from the owner of the host
+	h.OwnerId = aws.String("123456789012")
+	h.AvailabilityZone = aws.String("us-east-1a")
+	h.AvailabilityZoneId = aws.String("use1-az1")
+	h.State = types.AllocationStateAvailable
+	h.AutoPlacement = types.AutoPlacementOn
+	h.HostRecovery = types.HostRecoveryOff
+	h.AllowsMultipleInstanceTypes = types.AllowsMultipleInstanceTypesOff
+	h.HostReservationId = aws.String("hr-0a1b2c3d4e5f6a7b8")
+	allocated := time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)
+	h.AllocationTime = aws.Time(allocated)
+	h.ReleaseTime = aws.Time(allocated.AddDate(0, 1, 0))
+	h.HostProperties = &types.HostProperties{
+		Cores:          48,
+		InstanceFamily: aws.String("m5"),
+		InstanceType:   aws.String("m5.large"),
+		Sockets:        2,
+		TotalVCpus:     96,
+	}
+	h.AvailableCapacity = &types.AvailableCapacity{
+		AvailableVCpus:            92,
+		AvailableInstanceCapacity: []types.InstanceCapacity{{AvailableCapacity: 46, InstanceType: aws.String("m5.large"), TotalCapacity: 48}},
+	}
+	h.Instances = []types.HostInstance{{InstanceId: aws.String("i-0a1b2c3d4e5f6a7b8"), InstanceType: aws.String("m5.large"), OwnerId: h.OwnerId}}
+	h.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeHosts(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeHostsOutput{
+			Hosts: []types.Host{h},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2Hosts(t *testing.T) {
+	awsTestHelper(t, Ec2Hosts(), buildEc2Hosts)
+}
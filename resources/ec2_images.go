@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -136,6 +137,11 @@ func Ec2Images() *schema.Table {
 				Name: "virtualization_type",
 				Type: schema.TypeString,
 			},
+			{
+				Name:     "is_public",
+				Type:     schema.TypeBool,
+				Resolver: resolveEc2imageIsPublic,
+			},
 		},
 		Relations: []*schema.Table{
 			{
@@ -206,6 +212,25 @@ func Ec2Images() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:     "aws_ec2_image_launch_permissions",
+				Resolver: fetchEc2ImageLaunchPermissions,
+				Columns: []schema.Column{
+					{
+						Name:     "image_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "group",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "user_id",
+						Type: schema.TypeString,
+					},
+				},
+			},
 		},
 	}
 }
@@ -213,22 +238,103 @@ func Ec2Images() *schema.Table {
 // ====================================================================================================================
 //                                               Table Resolver Functions
 // ====================================================================================================================
+
+// wrappedImage holds the accounts and groups an image is shared with, as they're described by a separate call
+type wrappedImage struct {
+	types.Image
+	LaunchPermissions []types.LaunchPermission
+}
+
+// maxFilterValues is the maximum number of values of an ec2 describe filter
+const maxFilterValues = 200
+
 func fetchEc2Images(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	c := meta.(*client.Client)
 
 	svc := c.Services().EC2
-	response, err := svc.DescribeImages(ctx, &ec2.DescribeImagesInput{Owners: []string{"self"}}, func(options *ec2.Options) {
+	optFns := func(options *ec2.Options) {
 		options.Region = c.Region
 		options.EndpointResolver = ec2.EndpointResolverFromURL(fmt.Sprintf("https://ec2.%s.amazonaws.com", c.Region))
-	})
+	}
+	response, err := svc.DescribeImages(ctx, &ec2.DescribeImagesInput{Owners: []string{"self"}}, optFns)
 	if err != nil {
 		return err
 	}
-	res <- response.Images
+	fetched := response.Images
+	if c.InstanceImages() {
+		other, err := fetchEc2InstanceImages(ctx, c, response.Images, optFns)
+		if err != nil {
+			return err
+		}
+		fetched = append(fetched, other...)
+	}
+
+	images := make([]wrappedImage, 0, len(fetched))
+	for _, image := range fetched {
+		w := wrappedImage{Image: image}
+		// launch permissions can only be described by the owner of the image
+		if aws.ToString(image.OwnerId) == c.AccountID {
+			output, err := svc.DescribeImageAttribute(ctx, &ec2.DescribeImageAttributeInput{
+				Attribute: types.ImageAttributeNameLaunchPermission,
+				ImageId:   image.ImageId,
+			}, optFns)
+			if err != nil {
+				return err
+			}
+			w.LaunchPermissions = output.LaunchPermissions
+		}
+		images = append(images, w)
+	}
+	res <- images
 	return nil
 }
+
+// fetchEc2InstanceImages returns the images instances were launched from that aren't in owned, i.e public or
+// marketplace images of other accounts. The instances are read from the listing shared with aws_ec2_instances and
+// every image they use is returned, whatever its creation date. Images that were deregistered since aren't returned.
+func fetchEc2InstanceImages(ctx context.Context, c *client.Client, owned []types.Image, optFns func(*ec2.Options)) ([]types.Image, error) {
+	known := make(map[string]bool, len(owned))
+	for _, image := range owned {
+		known[aws.ToString(image.ImageId)] = true
+	}
+	instances, err := listEc2Instances(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, instance := range instances {
+		id := aws.ToString(instance.ImageId)
+		if id != "" && !known[id] {
+			known[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return describeEc2ImagesByID(ctx, c, ids, optFns)
+}
+
+// describeEc2ImagesByID describes the images with the given ids, skipping deregistered ones
+func describeEc2ImagesByID(ctx context.Context, c *client.Client, ids []string, optFns func(*ec2.Options)) ([]types.Image, error) {
+	svc := c.Services().EC2
+	var images []types.Image
+	for len(ids) > 0 {
+		batch := ids
+		if len(batch) > maxFilterValues {
+			batch = batch[:maxFilterValues]
+		}
+		ids = ids[len(batch):]
+		// the image-id filter skips deregistered images instead of failing the call like ImageIds
+		output, err := svc.DescribeImages(ctx, &ec2.DescribeImagesInput{
+			Filters: []types.Filter{{Name: aws.String("image-id"), Values: batch}},
+		}, optFns)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, output.Images...)
+	}
+	return images, nil
+}
 func resolveEc2imageProductCodes(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-	r := resource.Item.(types.Image)
+	r := resource.Item.(wrappedImage)
 	productCodes := map[string]string{}
 	for _, t := range r.ProductCodes {
 		productCodes[*t.ProductCodeId] = string(t.ProductCodeType)
@@ -236,15 +342,32 @@ func resolveEc2imageProductCodes(ctx context.Context, meta schema.ClientMeta, re
 	return resource.Set("product_codes", productCodes)
 }
 func resolveEc2imageTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-	r := resource.Item.(types.Image)
+	r := resource.Item.(wrappedImage)
 	tags := map[string]*string{}
 	for _, t := range r.Tags {
 		tags[*t.Key] = t.Value
 	}
 	return resource.Set("tags", tags)
 }
+func resolveEc2imageIsPublic(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedImage)
+	if r.Public {
+		return resource.Set(c.Name, true)
+	}
+	for _, p := range r.LaunchPermissions {
+		if p.Group == types.PermissionGroupAll {
+			return resource.Set(c.Name, true)
+		}
+	}
+	return resource.Set(c.Name, false)
+}
 func fetchEc2ImageBlockDeviceMappings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	r := parent.Item.(types.Image)
+	r := parent.Item.(wrappedImage)
 	res <- r.BlockDeviceMappings
 	return nil
 }
+func fetchEc2ImageLaunchPermissions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r := parent.Item.(wrappedImage)
+	res <- r.LaunchPermissions
+	return nil
+}
@@ -0,0 +1,57 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestFetchEc2InstanceImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec2Client := mocks.NewMockEc2Client(ctrl)
+	// the instances are listed once for both the instances table and the images
+	ec2Client.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+		&ec2.DescribeInstancesOutput{Reservations: []types.Reservation{{
+			Instances: []types.Instance{
+				{InstanceId: aws.String("i-1"), ImageId: aws.String("ami-owned")},
+				{InstanceId: aws.String("i-2"), ImageId: aws.String("ami-public")},
+				{InstanceId: aws.String("i-3"), ImageId: aws.String("ami-public")},
+			},
+		}}}, nil)
+	ec2Client.EXPECT().DescribeImages(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *ec2.DescribeImagesInput, _ ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+			if len(input.Filters) != 1 || len(input.Filters[0].Values) != 1 || input.Filters[0].Values[0] != "ami-public" {
+				t.Errorf("unexpected image filters %+v", input.Filters)
+			}
+			return &ec2.DescribeImagesOutput{Images: []types.Image{{
+				ImageId:      aws.String("ami-public"),
+				OwnerId:      aws.String("other"),
+				CreationDate: aws.String("2015-01-01T00:00:00.000Z"),
+			}}}, nil
+		})
+
+	c := client.NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("testAccount", "us-east-1", client.Services{EC2: ec2Client})
+	rc := client.AccountRegionMultiplex(&c)[0].(*client.Client)
+
+	res := make(chan interface{}, 1)
+	if err := fetchEc2Instances(context.Background(), rc, nil, res); err != nil {
+		t.Fatal(err)
+	}
+	images, err := fetchEc2InstanceImages(context.Background(), rc, []types.Image{{ImageId: aws.String("ami-owned")}}, func(*ec2.Options) {})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 1 || aws.ToString(images[0].ImageId) != "ami-public" {
+		t.Fatalf("expected the public image used by instances, got %+v", images)
+	}
+}
@@ -0,0 +1,191 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const (
+	instanceStatusKindInstance = "instance"
+	instanceStatusKindSystem   = "system"
+)
+
+// instanceStatusDetail is a status check of an instance, Kind tells instance checks from system checks
+type instanceStatusDetail struct {
+	types.InstanceStatusDetails
+	Kind string
+}
+
+func Ec2InstanceStatuses() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_instance_statuses",
+		Resolver:     fetchEc2InstanceStatuses,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "instance_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "outpost_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "instance_state_code",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("InstanceState.Code"),
+			},
+			{
+				Name:     "instance_state_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("InstanceState.Name"),
+			},
+			{
+				Name:     "instance_status",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("InstanceStatus.Status"),
+			},
+			{
+				Name:     "system_status",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SystemStatus.Status"),
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_instance_status_details",
+				Resolver: fetchEc2InstanceStatusDetails,
+				Columns: []schema.Column{
+					{
+						Name:     "instance_status_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "kind",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "name",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "status",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "impaired_since",
+						Type: schema.TypeTimestamp,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_instance_status_events",
+				Resolver: fetchEc2InstanceStatusEvents,
+				Columns: []schema.Column{
+					{
+						Name:     "instance_status_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "code",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "description",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "instance_event_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "not_after",
+						Type: schema.TypeTimestamp,
+					},
+					{
+						Name: "not_before",
+						Type: schema.TypeTimestamp,
+					},
+					{
+						Name: "not_before_deadline",
+						Type: schema.TypeTimestamp,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2InstanceStatuses(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	config := ec2.DescribeInstanceStatusInput{IncludeAllInstances: true}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeInstanceStatus(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.InstanceStatuses
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func fetchEc2InstanceStatusDetails(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.InstanceStatus)
+	if !ok {
+		return fmt.Errorf("expected types.InstanceStatus but got %T", parent.Item)
+	}
+	var details []instanceStatusDetail
+	appendDetails := func(kind string, summary *types.InstanceStatusSummary) {
+		if summary == nil {
+			return
+		}
+		for _, d := range summary.Details {
+			details = append(details, instanceStatusDetail{InstanceStatusDetails: d, Kind: kind})
+		}
+	}
+	appendDetails(instanceStatusKindInstance, r.InstanceStatus)
+	appendDetails(instanceStatusKindSystem, r.SystemStatus)
+	res <- details
+	return nil
+}
+func fetchEc2InstanceStatusEvents(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.InstanceStatus)
+	if !ok {
+		return fmt.Errorf("expected types.InstanceStatus but got %T", parent.Item)
+	}
+	res <- r.Events
+	return nil
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2InstanceStatuses(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	s := types.InstanceStatus{}
+	err := faker.FakeData(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.InstanceId = aws.String("i-0a1b2c3d4e5f6a7b8")
+	s.AvailabilityZone = aws.String("us-east-1a")
+	s.OutpostArn = aws.String("arn:aws:outposts:us-east-1:123456789012:outpost/op-0a1b2c3d4e5f6a7b8")
+	s.InstanceState = &types.InstanceState{Code: 16, Name: types.InstanceStateNameRunning}
+	impaired := time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)
+	// the details of both summaries are stored in one table, told apart by their kind
+	s.InstanceStatus = &types.InstanceStatusSummary{
+		Status: types.SummaryStatusImpaired,
+		Details: []types.InstanceStatusDetails{{
+			Name:          types.StatusNameReachability,
+			Status:        types.StatusTypeFailed,
+			ImpairedSince: aws.Time(impaired),
+		}},
+	}
+	s.SystemStatus = &types.InstanceStatusSummary{
+		Status: types.SummaryStatusOk,
+		Details: []types.InstanceStatusDetails{{
+			Name:          types.StatusNameReachability,
+			Status:        types.StatusTypePassed,
+			ImpairedSince: aws.Time(impaired.Add(-time.Hour)),
+		}},
+	}
+	s.Events = []types.InstanceStatusEvent{{
+		Code:              types.EventCodeSystemReboot,
+		Description:       aws.String("scheduled reboot"),
+		InstanceEventId:   aws.String("instance-event-0a1b2c3d4e5f6a7b8"),
+		NotBefore:         aws.Time(impaired.AddDate(0, 0, 7)),
+		NotAfter:          aws.Time(impaired.AddDate(0, 0, 8)),
+		NotBeforeDeadline: aws.Time(impaired.AddDate(0, 0, 14)),
+	}}
+
+	m.EXPECT().DescribeInstanceStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []types.InstanceStatus{s},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2InstanceStatuses(t *testing.T) {
+	awsTestHelper(t, Ec2InstanceStatuses(), buildEc2InstanceStatuses)
+}
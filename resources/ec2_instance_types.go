@@ -0,0 +1,364 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2InstanceTypes() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_instance_types",
+		Description:  "Instance types available in the region, fetched once per region as they don't differ between accounts",
+		Resolver:     fetchEc2InstanceTypes,
+		Multiplex:    client.RegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "instance_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "auto_recovery_supported",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "bare_metal",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "burstable_performance_supported",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "current_generation",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "dedicated_hosts_supported",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "free_tier_eligible",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "hibernation_supported",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "hypervisor",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_storage_supported",
+				Type: schema.TypeBool,
+			},
+			{
+				Name:     "supported_root_device_types",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2InstanceTypeSupportedRootDeviceTypes,
+			},
+			{
+				Name:     "supported_usage_classes",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2InstanceTypeSupportedUsageClasses,
+			},
+			{
+				Name:     "supported_virtualization_types",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2InstanceTypeSupportedVirtualizationTypes,
+			},
+			{
+				Name:     "sustained_clock_speed_in_ghz",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("ProcessorInfo.SustainedClockSpeedInGhz"),
+			},
+			{
+				Name:     "default_vcpus",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("VCpuInfo.DefaultVCpus"),
+			},
+			{
+				Name:     "default_cores",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("VCpuInfo.DefaultCores"),
+			},
+			{
+				Name:     "default_threads_per_core",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("VCpuInfo.DefaultThreadsPerCore"),
+			},
+			{
+				Name:     "memory_size_in_mib",
+				Type:     schema.TypeBigInt,
+				Resolver: schema.PathResolver("MemoryInfo.SizeInMiB"),
+			},
+			{
+				Name:     "network_performance",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("NetworkInfo.NetworkPerformance"),
+			},
+			{
+				Name:     "maximum_network_interfaces",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("NetworkInfo.MaximumNetworkInterfaces"),
+			},
+			{
+				Name:     "maximum_network_cards",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("NetworkInfo.MaximumNetworkCards"),
+			},
+			{
+				Name:     "ipv4_addresses_per_interface",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("NetworkInfo.Ipv4AddressesPerInterface"),
+			},
+			{
+				Name:     "ipv6_addresses_per_interface",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("NetworkInfo.Ipv6AddressesPerInterface"),
+			},
+			{
+				Name:     "ipv6_supported",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("NetworkInfo.Ipv6Supported"),
+			},
+			{
+				Name:     "ena_support",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("NetworkInfo.EnaSupport"),
+			},
+			{
+				Name:     "efa_supported",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("NetworkInfo.EfaSupported"),
+			},
+			{
+				Name:     "ebs_optimized_support",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedSupport"),
+			},
+			{
+				Name:     "ebs_encryption_support",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("EbsInfo.EncryptionSupport"),
+			},
+			{
+				Name:     "ebs_nvme_support",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("EbsInfo.NvmeSupport"),
+			},
+			{
+				Name:     "ebs_baseline_bandwidth_in_mbps",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.BaselineBandwidthInMbps"),
+			},
+			{
+				Name:     "ebs_baseline_iops",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.BaselineIops"),
+			},
+			{
+				Name:     "ebs_baseline_throughput_in_mbps",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.BaselineThroughputInMBps"),
+			},
+			{
+				Name:     "ebs_maximum_bandwidth_in_mbps",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.MaximumBandwidthInMbps"),
+			},
+			{
+				Name:     "ebs_maximum_iops",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.MaximumIops"),
+			},
+			{
+				Name:     "ebs_maximum_throughput_in_mbps",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("EbsInfo.EbsOptimizedInfo.MaximumThroughputInMBps"),
+			},
+			{
+				Name:     "instance_storage_total_size_in_gb",
+				Type:     schema.TypeBigInt,
+				Resolver: schema.PathResolver("InstanceStorageInfo.TotalSizeInGB"),
+			},
+			{
+				Name:     "instance_storage_nvme_support",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("InstanceStorageInfo.NvmeSupport"),
+			},
+			{
+				Name:     "gpu_total_memory_in_mib",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("GpuInfo.TotalGpuMemoryInMiB"),
+			},
+			{
+				Name:     "gpus",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2InstanceTypeGpus,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_instance_type_offerings",
+				Resolver: fetchEc2InstanceTypeOfferings,
+				Columns: []schema.Column{
+					{
+						Name:     "instance_type_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "location",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "location_type",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_instance_type_supported_architectures",
+				Resolver: fetchEc2InstanceTypeSupportedArchitectures,
+				Columns: []schema.Column{
+					{
+						Name:     "instance_type_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name:     "architecture",
+						Type:     schema.TypeString,
+						Resolver: resolveEc2InstanceTypeSupportedArchitectureArchitecture,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+// wrappedInstanceType holds the availability zones an instance type is offered in, they're described by a separate
+// call for every instance type of the region
+type wrappedInstanceType struct {
+	types.InstanceTypeInfo
+	Offerings []types.InstanceTypeOffering
+}
+
+func fetchEc2InstanceTypes(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	// availability zone names map to different zones in every account, their ids don't
+	offerings := make(map[types.InstanceType][]types.InstanceTypeOffering)
+	offeringsConfig := ec2.DescribeInstanceTypeOfferingsInput{LocationType: types.LocationTypeAvailabilityZoneId}
+	for {
+		output, err := svc.DescribeInstanceTypeOfferings(ctx, &offeringsConfig, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		for _, o := range output.InstanceTypeOfferings {
+			offerings[o.InstanceType] = append(offerings[o.InstanceType], o)
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		offeringsConfig.NextToken = output.NextToken
+	}
+
+	seen := make(map[types.InstanceType]bool)
+	var config ec2.DescribeInstanceTypesInput
+	for {
+		output, err := svc.DescribeInstanceTypes(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		instanceTypes := make([]wrappedInstanceType, 0, len(output.InstanceTypes))
+		for _, t := range output.InstanceTypes {
+			if seen[t.InstanceType] {
+				continue
+			}
+			seen[t.InstanceType] = true
+			instanceTypes = append(instanceTypes, wrappedInstanceType{InstanceTypeInfo: t, Offerings: offerings[t.InstanceType]})
+		}
+		res <- instanceTypes
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2InstanceTypeSupportedRootDeviceTypes(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedInstanceType)
+	values := make([]string, 0, len(r.SupportedRootDeviceTypes))
+	for _, v := range r.SupportedRootDeviceTypes {
+		values = append(values, string(v))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2InstanceTypeSupportedUsageClasses(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedInstanceType)
+	values := make([]string, 0, len(r.SupportedUsageClasses))
+	for _, v := range r.SupportedUsageClasses {
+		values = append(values, string(v))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2InstanceTypeSupportedVirtualizationTypes(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedInstanceType)
+	values := make([]string, 0, len(r.SupportedVirtualizationTypes))
+	for _, v := range r.SupportedVirtualizationTypes {
+		values = append(values, string(v))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2InstanceTypeGpus(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedInstanceType)
+	if r.GpuInfo == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.GpuInfo.Gpus)
+}
+func fetchEc2InstanceTypeOfferings(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(wrappedInstanceType)
+	if !ok {
+		return fmt.Errorf("expected wrappedInstanceType but got %T", parent.Item)
+	}
+	res <- r.Offerings
+	return nil
+}
+func fetchEc2InstanceTypeSupportedArchitectures(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(wrappedInstanceType)
+	if !ok {
+		return fmt.Errorf("expected wrappedInstanceType but got %T", parent.Item)
+	}
+	if r.ProcessorInfo == nil {
+		return nil
+	}
+	res <- r.ProcessorInfo.SupportedArchitectures
+	return nil
+}
+func resolveEc2InstanceTypeSupportedArchitectureArchitecture(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	return resource.Set(c.Name, string(resource.Item.(types.ArchitectureType)))
+}
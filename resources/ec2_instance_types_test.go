@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2InstanceTypes(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	// offerings are listed by availability zone id and matched to their instance type
+	offerings := []types.InstanceTypeOffering{
+		{InstanceType: types.InstanceTypeG4dnXlarge, Location: aws.String("use1-az1"), LocationType: types.LocationTypeAvailabilityZoneId},
+		{InstanceType: types.InstanceTypeG4dnXlarge, Location: aws.String("use1-az2"), LocationType: types.LocationTypeAvailabilityZoneId},
+	}
+	m.EXPECT().DescribeInstanceTypeOfferings(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeInstanceTypeOfferingsOutput{
+			InstanceTypeOfferings: offerings,
+		}, nil)
+
+	i := types.InstanceTypeInfo{}
+	err := faker.FakeData(&i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i.InstanceType = types.InstanceTypeG4dnXlarge
+	i.Hypervisor = types.InstanceTypeHypervisorNitro
+	i.SupportedRootDeviceTypes = []types.RootDeviceType{types.RootDeviceTypeEbs}
+	i.SupportedUsageClasses = []types.UsageClassType{types.UsageClassTypeOnDemand, types.UsageClassTypeSpot}
+	i.SupportedVirtualizationTypes = []types.VirtualizationType{types.VirtualizationTypeHvm}
+	i.ProcessorInfo.SupportedArchitectures = []types.ArchitectureType{types.ArchitectureTypeX8664}
+	i.ProcessorInfo.SustainedClockSpeedInGhz = aws.Float64(2.5)
+	i.VCpuInfo.DefaultVCpus = aws.Int32(4)
+	i.VCpuInfo.DefaultCores = aws.Int32(2)
+	i.VCpuInfo.DefaultThreadsPerCore = aws.Int32(2)
+	i.MemoryInfo.SizeInMiB = aws.Int64(16384)
+	i.NetworkInfo.NetworkPerformance = aws.String("Up to 25 Gigabit")
+	i.NetworkInfo.EnaSupport = types.EnaSupportRequired
+	i.EbsInfo.EbsOptimizedSupport = types.EbsOptimizedSupportDefault
+	i.EbsInfo.EncryptionSupport = types.EbsEncryptionSupportSupported
+	i.EbsInfo.NvmeSupport = types.EbsNvmeSupportRequired
+	i.InstanceStorageInfo.TotalSizeInGB = aws.Int64(125)
+	i.InstanceStorageInfo.NvmeSupport = types.EphemeralNvmeSupportRequired
+	// the gpus are stored as json
+	i.GpuInfo = &types.GpuInfo{
+		TotalGpuMemoryInMiB: aws.Int32(16384),
+		Gpus: []types.GpuDeviceInfo{{
+			Count:        aws.Int32(1),
+			Manufacturer: aws.String("NVIDIA"),
+			Name:         aws.String("T4"),
+			MemoryInfo:   &types.GpuDeviceMemoryInfo{SizeInMiB: aws.Int32(16384)},
+		}},
+	}
+	m.EXPECT().DescribeInstanceTypes(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []types.InstanceTypeInfo{i},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2InstanceTypes(t *testing.T) {
+	awsTestHelper(t, Ec2InstanceTypes(), buildEc2InstanceTypes)
+}
@@ -2,21 +2,29 @@ package resources
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"sync"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 )
 
 func Ec2Instances() *schema.Table {
 	return &schema.Table{
-		Name:         "aws_ec2_instances",
-		Resolver:     fetchEc2Instances,
-		Multiplex:    client.AccountRegionMultiplex,
-		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		Name:                 "aws_ec2_instances",
+		Resolver:             fetchEc2Instances,
+		Multiplex:            client.AccountRegionMultiplex,
+		IgnoreError:          client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter:         client.DeleteTargetFilter(client.DeleteAccountRegionFilter, "ec2", "instance", "instance_id"),
+		PostResourceResolver: resolveEc2InstanceAttributes,
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -69,6 +77,10 @@ func Ec2Instances() *schema.Table {
 				Type:     schema.TypeInt,
 				Resolver: schema.PathResolver("CpuOptions.ThreadsPerCore"),
 			},
+			{
+				Name: "disable_api_termination",
+				Type: schema.TypeBool,
+			},
 			{
 				Name: "ebs_optimized",
 				Type: schema.TypeBool,
@@ -109,6 +121,10 @@ func Ec2Instances() *schema.Table {
 				Name: "instance_id",
 				Type: schema.TypeString,
 			},
+			{
+				Name: "instance_initiated_shutdown_behavior",
+				Type: schema.TypeString,
+			},
 			{
 				Name: "instance_lifecycle",
 				Type: schema.TypeString,
@@ -275,6 +291,19 @@ func Ec2Instances() *schema.Table {
 				Type:     schema.TypeJSON,
 				Resolver: resolveEc2instanceTags,
 			},
+			{
+				Name:        "user_data",
+				Type:        schema.TypeString,
+				Description: "The decoded user data, binary user data such as gzip compressed scripts is stored base64 encoded",
+			},
+			{
+				Name: "user_data_sha256",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "user_data_size",
+				Type: schema.TypeInt,
+			},
 			{
 				Name: "virtualization_type",
 				Type: schema.TypeString,
@@ -621,9 +650,15 @@ func Ec2Instances() *schema.Table {
 // ====================================================================================================================
 func fetchEc2Instances(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-
-	response, err := svc.DescribeInstances(ctx, &ec2.DescribeInstancesInput{}, func(o *ec2.Options) {
+	if !c.Targeted() {
+		return c.SendPages(ctx, ec2InstancesListing(c), res)
+	}
+	ids := c.TargetedResourceIDs("ec2", "instance")
+	if len(ids) == 0 {
+		return nil
+	}
+	config := ec2.DescribeInstancesInput{Filters: []types.Filter{{Name: aws.String("instance-id"), Values: ids}}}
+	response, err := c.Services().EC2.DescribeInstances(ctx, &config, func(o *ec2.Options) {
 		o.Region = c.Region
 	})
 	if err != nil {
@@ -636,6 +671,90 @@ func fetchEc2Instances(ctx context.Context, meta schema.ClientMeta, _ *schema.Re
 
 	return nil
 }
+
+// resolveEc2InstanceAttributes sets the instance attributes only returned by DescribeInstanceAttribute, when
+// instance_attributes is configured. The user data is stored decoded, redacting secrets when configured, or only as
+// its hash and size when hash_user_data is set. Binary user data is kept base64 encoded.
+func resolveEc2InstanceAttributes(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
+	c := meta.(*client.Client)
+	limiter := c.InstanceAttributes()
+	if limiter == nil {
+		return nil
+	}
+	r := resource.Item.(types.Instance)
+	// attributes of terminated instances can't be described anymore
+	if r.State != nil && r.State.Name == types.InstanceStateNameTerminated {
+		return nil
+	}
+	attributes := []types.InstanceAttributeName{
+		types.InstanceAttributeNameUserData,
+		types.InstanceAttributeNameDisableApiTermination,
+		types.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
+	}
+	outputs := make([]*ec2.DescribeInstanceAttributeOutput, len(attributes))
+	errs := make([]error, len(attributes))
+	var wg sync.WaitGroup
+	for i, attribute := range attributes {
+		wg.Add(1)
+		go func(i int, attribute types.InstanceAttributeName) {
+			defer wg.Done()
+			if errs[i] = limiter.Acquire(ctx); errs[i] != nil {
+				return
+			}
+			defer limiter.Release()
+			outputs[i], errs[i] = c.Services().EC2.DescribeInstanceAttribute(ctx, &ec2.DescribeInstanceAttributeInput{
+				Attribute:  attribute,
+				InstanceId: r.InstanceId,
+			}, func(o *ec2.Options) {
+				o.Region = c.Region
+			})
+		}(i, attribute)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		var ae smithy.APIError
+		if errors.As(err, &ae) && ae.ErrorCode() == "InvalidInstanceID.NotFound" {
+			// the instance was terminated after it was listed
+			c.Logger().Debug("instance not found, skipping its attributes", "instance_id", aws.ToString(r.InstanceId))
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if v := outputs[1].DisableApiTermination; v != nil {
+		if err := resource.Set("disable_api_termination", v.Value); err != nil {
+			return err
+		}
+	}
+	if v := outputs[2].InstanceInitiatedShutdownBehavior; v != nil {
+		if err := resource.Set("instance_initiated_shutdown_behavior", v.Value); err != nil {
+			return err
+		}
+	}
+	if outputs[0].UserData == nil || outputs[0].UserData.Value == nil {
+		return nil
+	}
+	userData := decodeUserData(aws.ToString(outputs[0].UserData.Value))
+	sum := sha256.Sum256([]byte(userData))
+	if err := resource.Set("user_data_sha256", hex.EncodeToString(sum[:])); err != nil {
+		return err
+	}
+	if err := resource.Set("user_data_size", len(userData)); err != nil {
+		return err
+	}
+	if c.HashUserData() {
+		return nil
+	}
+	if !userDataText(userData) {
+		return resource.Set("user_data", base64.StdEncoding.EncodeToString([]byte(userData)))
+	}
+	if c.RedactSecrets() {
+		userData = c.SecretScanner().Redact(userData)
+	}
+	return resource.Set("user_data", userData)
+}
 func resolveEc2instanceTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, _ schema.Column) error {
 	r := resource.Item.(types.Instance)
 	tags := map[string]*string{}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2KeyPairs() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_key_pairs",
+		Resolver:     fetchEc2KeyPairs,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "key_fingerprint",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "key_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "key_pair_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2KeyPairTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2KeyPairs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeKeyPairsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribeKeyPairs(ctx, &config, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.KeyPairs
+	return nil
+}
+func resolveEc2KeyPairTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.KeyPairInfo)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2KeyPairs(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	k := types.KeyPairInfo{}
+	err := faker.FakeData(&k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.KeyFingerprint = aws.String("1f:51:ae:28:bf:89:e9:d8:1f:25:5d:37:2d:7d:b8:ca:9f:f5:f1:6f")
+	k.KeyName = aws.String("test-key")
+	k.KeyPairId = aws.String("key-0a1b2c3d4e5f6a7b8")
+	k.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeKeyPairs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeKeyPairsOutput{
+			KeyPairs: []types.KeyPairInfo{k},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2KeyPairs(t *testing.T) {
+	awsTestHelper(t, Ec2KeyPairs(), buildEc2KeyPairs)
+}
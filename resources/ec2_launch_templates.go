@@ -0,0 +1,528 @@
+package resources
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2LaunchTemplates() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_launch_templates",
+		Resolver:     fetchEc2LaunchTemplates,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2LaunchTemplateArn,
+			},
+			{
+				Name: "create_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "created_by",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "default_version_number",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "latest_version_number",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "launch_template_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "launch_template_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2LaunchTemplateTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_launch_template_versions",
+				Resolver: fetchEc2LaunchTemplateVersions,
+				Columns: []schema.Column{
+					{
+						Name:     "launch_template_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "create_time",
+						Type: schema.TypeTimestamp,
+					},
+					{
+						Name: "created_by",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "default_version",
+						Type: schema.TypeBool,
+					},
+					{
+						Name: "version_description",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "version_number",
+						Type: schema.TypeBigInt,
+					},
+					{
+						Name:     "capacity_reservation_specification",
+						Type:     schema.TypeJSON,
+						Resolver: resolveEc2LaunchTemplateVersionCapacityReservationSpecification,
+					},
+					{
+						Name:     "cpu_options_core_count",
+						Type:     schema.TypeInt,
+						Resolver: schema.PathResolver("LaunchTemplateData.CpuOptions.CoreCount"),
+					},
+					{
+						Name:     "cpu_options_threads_per_core",
+						Type:     schema.TypeInt,
+						Resolver: schema.PathResolver("LaunchTemplateData.CpuOptions.ThreadsPerCore"),
+					},
+					{
+						Name:     "credit_specification_cpu_credits",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.CreditSpecification.CpuCredits"),
+					},
+					{
+						Name:     "disable_api_termination",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("LaunchTemplateData.DisableApiTermination"),
+					},
+					{
+						Name:     "ebs_optimized",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("LaunchTemplateData.EbsOptimized"),
+					},
+					{
+						Name:     "enclave_options_enabled",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("LaunchTemplateData.EnclaveOptions.Enabled"),
+					},
+					{
+						Name:     "hibernation_options_configured",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("LaunchTemplateData.HibernationOptions.Configured"),
+					},
+					{
+						Name:     "iam_instance_profile_arn",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.IamInstanceProfile.Arn"),
+					},
+					{
+						Name:     "iam_instance_profile_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.IamInstanceProfile.Name"),
+					},
+					{
+						Name:     "image_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.ImageId"),
+					},
+					{
+						Name:     "instance_initiated_shutdown_behavior",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.InstanceInitiatedShutdownBehavior"),
+					},
+					{
+						Name:     "instance_market_options",
+						Type:     schema.TypeJSON,
+						Resolver: resolveEc2LaunchTemplateVersionInstanceMarketOptions,
+					},
+					{
+						Name:     "instance_type",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.InstanceType"),
+					},
+					{
+						Name:     "kernel_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.KernelId"),
+					},
+					{
+						Name:     "key_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.KeyName"),
+					},
+					{
+						Name:     "metadata_options_http_endpoint",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.MetadataOptions.HttpEndpoint"),
+					},
+					{
+						Name:     "metadata_options_http_put_response_hop_limit",
+						Type:     schema.TypeInt,
+						Resolver: schema.PathResolver("LaunchTemplateData.MetadataOptions.HttpPutResponseHopLimit"),
+					},
+					{
+						Name:     "metadata_options_http_tokens",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.MetadataOptions.HttpTokens"),
+					},
+					{
+						Name:     "metadata_options_state",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.MetadataOptions.State"),
+					},
+					{
+						Name:     "monitoring_enabled",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("LaunchTemplateData.Monitoring.Enabled"),
+					},
+					{
+						Name:     "placement_affinity",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.Affinity"),
+					},
+					{
+						Name:     "placement_availability_zone",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.AvailabilityZone"),
+					},
+					{
+						Name:     "placement_group_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.GroupName"),
+					},
+					{
+						Name:     "placement_host_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.HostId"),
+					},
+					{
+						Name:     "placement_host_resource_group_arn",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.HostResourceGroupArn"),
+					},
+					{
+						Name:     "placement_partition_number",
+						Type:     schema.TypeInt,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.PartitionNumber"),
+					},
+					{
+						Name:     "placement_spread_domain",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.SpreadDomain"),
+					},
+					{
+						Name:     "placement_tenancy",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.Placement.Tenancy"),
+					},
+					{
+						Name:     "ram_disk_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("LaunchTemplateData.RamDiskId"),
+					},
+					{
+						Name:     "security_group_ids",
+						Type:     schema.TypeStringArray,
+						Resolver: schema.PathResolver("LaunchTemplateData.SecurityGroupIds"),
+					},
+					{
+						Name:     "security_groups",
+						Type:     schema.TypeStringArray,
+						Resolver: schema.PathResolver("LaunchTemplateData.SecurityGroups"),
+					},
+					{
+						Name:     "tag_specifications",
+						Type:     schema.TypeJSON,
+						Resolver: resolveEc2LaunchTemplateVersionTagSpecifications,
+					},
+					{
+						Name:        "user_data",
+						Type:        schema.TypeString,
+						Description: "The decoded user data, binary user data such as gzip compressed scripts is stored base64 encoded",
+						Resolver:    resolveEc2LaunchTemplateVersionUserData,
+					},
+				},
+				Relations: []*schema.Table{
+					{
+						Name:     "aws_ec2_launch_template_version_block_device_mappings",
+						Resolver: fetchEc2LaunchTemplateVersionBlockDeviceMappings,
+						Columns: []schema.Column{
+							{
+								Name:     "launch_template_version_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "device_name",
+								Type: schema.TypeString,
+							},
+							{
+								Name:     "ebs_delete_on_termination",
+								Type:     schema.TypeBool,
+								Resolver: schema.PathResolver("Ebs.DeleteOnTermination"),
+							},
+							{
+								Name:     "ebs_encrypted",
+								Type:     schema.TypeBool,
+								Resolver: schema.PathResolver("Ebs.Encrypted"),
+							},
+							{
+								Name:     "ebs_iops",
+								Type:     schema.TypeInt,
+								Resolver: schema.PathResolver("Ebs.Iops"),
+							},
+							{
+								Name:     "ebs_kms_key_id",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("Ebs.KmsKeyId"),
+							},
+							{
+								Name:     "ebs_snapshot_id",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("Ebs.SnapshotId"),
+							},
+							{
+								Name:     "ebs_throughput",
+								Type:     schema.TypeInt,
+								Resolver: schema.PathResolver("Ebs.Throughput"),
+							},
+							{
+								Name:     "ebs_volume_size",
+								Type:     schema.TypeInt,
+								Resolver: schema.PathResolver("Ebs.VolumeSize"),
+							},
+							{
+								Name:     "ebs_volume_type",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("Ebs.VolumeType"),
+							},
+							{
+								Name: "no_device",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "virtual_name",
+								Type: schema.TypeString,
+							},
+						},
+					},
+					{
+						Name:     "aws_ec2_launch_template_version_network_interfaces",
+						Resolver: fetchEc2LaunchTemplateVersionNetworkInterfaces,
+						Columns: []schema.Column{
+							{
+								Name:     "launch_template_version_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "associate_carrier_ip_address",
+								Type: schema.TypeBool,
+							},
+							{
+								Name: "associate_public_ip_address",
+								Type: schema.TypeBool,
+							},
+							{
+								Name: "delete_on_termination",
+								Type: schema.TypeBool,
+							},
+							{
+								Name: "description",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "device_index",
+								Type: schema.TypeInt,
+							},
+							{
+								Name: "groups",
+								Type: schema.TypeStringArray,
+							},
+							{
+								Name: "interface_type",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "ipv6_address_count",
+								Type: schema.TypeInt,
+							},
+							{
+								Name:     "ipv6_addresses",
+								Type:     schema.TypeStringArray,
+								Resolver: resolveEc2LaunchTemplateVersionNetworkInterfaceIpv6Addresses,
+							},
+							{
+								Name: "network_card_index",
+								Type: schema.TypeInt,
+							},
+							{
+								Name: "network_interface_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "private_ip_address",
+								Type: schema.TypeString,
+							},
+							{
+								Name:     "private_ip_addresses",
+								Type:     schema.TypeJSON,
+								Resolver: resolveEc2LaunchTemplateVersionNetworkInterfacePrivateIpAddresses,
+							},
+							{
+								Name: "secondary_private_ip_address_count",
+								Type: schema.TypeInt,
+							},
+							{
+								Name: "subnet_id",
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2LaunchTemplates(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	return c.SendPages(ctx, ec2LaunchTemplatesListing(c), res)
+}
+func resolveEc2LaunchTemplateArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.LaunchTemplate)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "launch-template", aws.ToString(r.LaunchTemplateId), cl.Region, cl.AccountID))
+}
+func resolveEc2LaunchTemplateTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplate)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2LaunchTemplateVersions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.LaunchTemplate)
+	if !ok {
+		return fmt.Errorf("expected types.LaunchTemplate but got %T", parent.Item)
+	}
+	versions, err := listEc2LaunchTemplateVersions(ctx, meta.(*client.Client), r.LaunchTemplateId)
+	if err != nil {
+		return err
+	}
+	res <- versions
+	return nil
+}
+func resolveEc2LaunchTemplateVersionCapacityReservationSpecification(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateVersion)
+	if r.LaunchTemplateData == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.LaunchTemplateData.CapacityReservationSpecification)
+}
+func resolveEc2LaunchTemplateVersionInstanceMarketOptions(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateVersion)
+	if r.LaunchTemplateData == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.LaunchTemplateData.InstanceMarketOptions)
+}
+func resolveEc2LaunchTemplateVersionTagSpecifications(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateVersion)
+	if r.LaunchTemplateData == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.LaunchTemplateData.TagSpecifications)
+}
+
+// resolveJSONColumn sets a json column from an sdk struct, leaving it null when the value isn't set
+func resolveJSONColumn(resource *schema.Resource, c schema.Column, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if string(data) == "null" {
+		return nil
+	}
+	return resource.Set(c.Name, data)
+}
+
+// resolveEc2LaunchTemplateVersionUserData stores the decoded user data, so it can be searched, redacting secrets
+// when configured. Binary user data is kept base64 encoded.
+func resolveEc2LaunchTemplateVersionUserData(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateVersion)
+	if r.LaunchTemplateData == nil || r.LaunchTemplateData.UserData == nil {
+		return nil
+	}
+	userData := decodeUserData(aws.ToString(r.LaunchTemplateData.UserData))
+	if !userDataText(userData) {
+		return resource.Set(c.Name, base64.StdEncoding.EncodeToString([]byte(userData)))
+	}
+	if cl := meta.(*client.Client); cl.RedactSecrets() {
+		userData = cl.SecretScanner().Redact(userData)
+	}
+	return resource.Set(c.Name, userData)
+}
+func fetchEc2LaunchTemplateVersionBlockDeviceMappings(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.LaunchTemplateVersion)
+	if !ok {
+		return fmt.Errorf("expected types.LaunchTemplateVersion but got %T", parent.Item)
+	}
+	if r.LaunchTemplateData == nil {
+		return nil
+	}
+	res <- r.LaunchTemplateData.BlockDeviceMappings
+	return nil
+}
+func fetchEc2LaunchTemplateVersionNetworkInterfaces(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.LaunchTemplateVersion)
+	if !ok {
+		return fmt.Errorf("expected types.LaunchTemplateVersion but got %T", parent.Item)
+	}
+	if r.LaunchTemplateData == nil {
+		return nil
+	}
+	res <- r.LaunchTemplateData.NetworkInterfaces
+	return nil
+}
+func resolveEc2LaunchTemplateVersionNetworkInterfaceIpv6Addresses(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateInstanceNetworkInterfaceSpecification)
+	addresses := make([]string, 0, len(r.Ipv6Addresses))
+	for _, a := range r.Ipv6Addresses {
+		addresses = append(addresses, aws.ToString(a.Ipv6Address))
+	}
+	return resource.Set(c.Name, addresses)
+}
+func resolveEc2LaunchTemplateVersionNetworkInterfacePrivateIpAddresses(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.LaunchTemplateInstanceNetworkInterfaceSpecification)
+	return resolveJSONColumn(resource, c, r.PrivateIpAddresses)
+}
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+)
+
+func buildEc2LaunchTemplates(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.LaunchTemplate{}
+	if err := faker.FakeData(&r); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeLaunchTemplates(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeLaunchTemplatesOutput{
+			LaunchTemplates: []types.LaunchTemplate{r},
+		}, nil)
+	v := types.LaunchTemplateVersion{}
+	if err := faker.FakeData(&v); err != nil {
+		t.Fatal(err)
+	}
+	v.LaunchTemplateData.UserData = aws.String("IyEvYmluL2Jhc2gKZWNobyBoZWxsbw==")
+	m.EXPECT().DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []types.LaunchTemplateVersion{v},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2LaunchTemplates(t *testing.T) {
+	awsTestHelper(t, Ec2LaunchTemplates(), buildEc2LaunchTemplates)
+}
+
+func TestResolveEc2LaunchTemplateVersionUserData(t *testing.T) {
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	if _, err := w.Write([]byte("#!/bin/bash\necho hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	c := client.NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	table := Ec2LaunchTemplates().Relations[0]
+	for _, tc := range []struct {
+		userData string
+		expected string
+	}{
+		{"IyEvYmluL2Jhc2gKZWNobyBoZWxsbw==", "#!/bin/bash\necho hello"},
+		// gzip compressed user data isn't valid text, so it stays base64 encoded
+		{base64.StdEncoding.EncodeToString(compressed.Bytes()), base64.StdEncoding.EncodeToString(compressed.Bytes())},
+		{base64.StdEncoding.EncodeToString([]byte("a\x00b")), "YQBi"},
+	} {
+		resource := schema.NewResourceData(table, nil, types.LaunchTemplateVersion{
+			LaunchTemplateData: &types.ResponseLaunchTemplateData{UserData: aws.String(tc.userData)},
+		})
+		if err := resolveEc2LaunchTemplateVersionUserData(context.Background(), &c, resource, schema.Column{Name: "user_data"}); err != nil {
+			t.Fatal(err)
+		}
+		if v := resource.Get("user_data"); v != tc.expected {
+			t.Errorf("expected user data %q, got %q", tc.expected, v)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2ManagedPrefixLists() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_managed_prefix_lists",
+		Resolver:     fetchEc2ManagedPrefixLists,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "address_family",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "max_entries",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PrefixListArn"),
+			},
+			{
+				Name: "prefix_list_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "prefix_list_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "state_message",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2ManagedPrefixListTags,
+			},
+			{
+				Name: "version",
+				Type: schema.TypeBigInt,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_managed_prefix_list_entries",
+				Resolver: fetchEc2ManagedPrefixListEntries,
+				Columns: []schema.Column{
+					{
+						Name:     "managed_prefix_list_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "cidr",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "description",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2ManagedPrefixLists(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeManagedPrefixListsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeManagedPrefixLists(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.PrefixLists
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2ManagedPrefixListTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.ManagedPrefixList)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2ManagedPrefixListEntries(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.ManagedPrefixList)
+	if !ok {
+		return fmt.Errorf("expected types.ManagedPrefixList but got %T", parent.Item)
+	}
+	// entries of the version that was described, the list may be modified while it's fetched
+	config := ec2.GetManagedPrefixListEntriesInput{PrefixListId: r.PrefixListId, TargetVersion: r.Version}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.GetManagedPrefixListEntries(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.Entries
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
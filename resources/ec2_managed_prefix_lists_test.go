@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2ManagedPrefixLists(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	l := types.ManagedPrefixList{}
+	err := faker.FakeData(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.PrefixListId = aws.String("pl-0a1b2c3d4e5f6a7b8")
+	l.PrefixListName = aws.String("office-networks")
+	l.PrefixListArn = aws.String("arn:aws:ec2:us-east-1:123456789012:prefix-list/pl-0a1b2c3d4e5f6a7b8")
+	l.OwnerId = aws.String("123456789012")
+	l.AddressFamily = aws.String("IPv4")
+	l.MaxEntries = 10
+	l.Version = 2
+	l.State = types.PrefixListStateModifyComplete
+	l.StateMessage = aws.String("modified")
+	l.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+	m.EXPECT().DescribeManagedPrefixLists(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeManagedPrefixListsOutput{
+			PrefixLists: []types.ManagedPrefixList{l},
+		}, nil)
+
+	// the entries are listed for every prefix list
+	m.EXPECT().GetManagedPrefixListEntries(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.GetManagedPrefixListEntriesOutput{
+			Entries: []types.PrefixListEntry{{Cidr: aws.String("203.0.113.0/24"), Description: aws.String("office")}},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2ManagedPrefixLists(t *testing.T) {
+	awsTestHelper(t, Ec2ManagedPrefixLists(), buildEc2ManagedPrefixLists)
+}
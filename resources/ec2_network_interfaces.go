@@ -0,0 +1,318 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2NetworkInterfaces() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_network_interfaces",
+		Resolver:     fetchEc2NetworkInterfaces,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2NetworkInterfaceArn,
+			},
+			{
+				Name:     "association_allocation_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.AllocationId"),
+			},
+			{
+				Name:     "association_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.AssociationId"),
+			},
+			{
+				Name:     "association_carrier_ip",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.CarrierIp"),
+			},
+			{
+				Name:     "association_customer_owned_ip",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.CustomerOwnedIp"),
+			},
+			{
+				Name:     "association_ip_owner_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.IpOwnerId"),
+			},
+			{
+				Name:     "association_public_dns_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.PublicDnsName"),
+			},
+			{
+				Name:     "association_public_ip",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Association.PublicIp"),
+			},
+			{
+				Name:     "attachment_attach_time",
+				Type:     schema.TypeTimestamp,
+				Resolver: schema.PathResolver("Attachment.AttachTime"),
+			},
+			{
+				Name:     "attachment_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Attachment.AttachmentId"),
+			},
+			{
+				Name:     "attachment_delete_on_termination",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("Attachment.DeleteOnTermination"),
+			},
+			{
+				Name:     "attachment_device_index",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("Attachment.DeviceIndex"),
+			},
+			{
+				Name:     "attachment_instance_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Attachment.InstanceId"),
+			},
+			{
+				Name:     "attachment_instance_owner_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Attachment.InstanceOwnerId"),
+			},
+			{
+				Name:     "attachment_network_card_index",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("Attachment.NetworkCardIndex"),
+			},
+			{
+				Name:     "attachment_status",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Attachment.Status"),
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "description",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "interface_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "mac_address",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "network_interface_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "outpost_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "owner_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "private_dns_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "private_ip_address",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "requester_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "requester_managed",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "source_dest_check",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "status",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "subnet_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2NetworkInterfaceTags,
+			},
+			{
+				Name: "vpc_id",
+				Type: schema.TypeString,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_network_interface_groups",
+				Resolver: fetchEc2NetworkInterfaceGroups,
+				Columns: []schema.Column{
+					{
+						Name:     "network_interface_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "group_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "group_name",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_network_interface_ipv6_addresses",
+				Resolver: fetchEc2NetworkInterfaceIpv6Addresses,
+				Columns: []schema.Column{
+					{
+						Name:     "network_interface_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "ipv6_address",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_network_interface_private_ip_addresses",
+				Resolver: fetchEc2NetworkInterfacePrivateIpAddresses,
+				Columns: []schema.Column{
+					{
+						Name:     "network_interface_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name:     "association_allocation_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.AllocationId"),
+					},
+					{
+						Name:     "association_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.AssociationId"),
+					},
+					{
+						Name:     "association_carrier_ip",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.CarrierIp"),
+					},
+					{
+						Name:     "association_customer_owned_ip",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.CustomerOwnedIp"),
+					},
+					{
+						Name:     "association_ip_owner_id",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.IpOwnerId"),
+					},
+					{
+						Name:     "association_public_dns_name",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.PublicDnsName"),
+					},
+					{
+						Name:     "association_public_ip",
+						Type:     schema.TypeString,
+						Resolver: schema.PathResolver("Association.PublicIp"),
+					},
+					{
+						Name:     "is_primary",
+						Type:     schema.TypeBool,
+						Resolver: schema.PathResolver("Primary"),
+					},
+					{
+						Name: "private_dns_name",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "private_ip_address",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2NetworkInterfaces(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	return c.SendPages(ctx, ec2NetworkInterfacesListing(c), res)
+}
+func resolveEc2NetworkInterfaceArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.NetworkInterface)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "network-interface", aws.ToString(r.NetworkInterfaceId), cl.Region, cl.AccountID))
+}
+func fetchEc2NetworkInterfaceGroups(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("expected types.NetworkInterface but got %T", parent.Item)
+	}
+	res <- r.Groups
+	return nil
+}
+func fetchEc2NetworkInterfaceIpv6Addresses(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("expected types.NetworkInterface but got %T", parent.Item)
+	}
+	res <- r.Ipv6Addresses
+	return nil
+}
+func fetchEc2NetworkInterfacePrivateIpAddresses(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.NetworkInterface)
+	if !ok {
+		return fmt.Errorf("expected types.NetworkInterface but got %T", parent.Item)
+	}
+	res <- r.PrivateIpAddresses
+	return nil
+}
+func resolveEc2NetworkInterfaceTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.NetworkInterface)
+	tags := map[string]*string{}
+	for _, t := range r.TagSet {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2NetworkInterfaces(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.NetworkInterface{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.NetworkInterfaceId = aws.String("eni-0a1b2c3d4e5f6a7b8")
+	r.OwnerId = aws.String("123456789012")
+	r.VpcId = aws.String("vpc-0a1b2c3d")
+	r.SubnetId = aws.String("subnet-0a1b2c3d")
+	r.AvailabilityZone = aws.String("us-east-1a")
+	r.InterfaceType = types.NetworkInterfaceTypeInterface
+	r.Status = types.NetworkInterfaceStatusInUse
+	r.MacAddress = aws.String("0a:1b:2c:3d:4e:5f")
+	r.PrivateIpAddress = aws.String("10.0.1.10")
+	r.PrivateDnsName = aws.String("ip-10-0-1-10.ec2.internal")
+	r.OutpostArn = aws.String("arn:aws:outposts:us-east-1:123456789012:outpost/op-0a1b2c3d4e5f6a7b8")
+	r.Association = &types.NetworkInterfaceAssociation{
+		AllocationId:    aws.String("eipalloc-0a1b2c3d"),
+		AssociationId:   aws.String("eipassoc-0a1b2c3d"),
+		CarrierIp:       aws.String("155.146.0.10"),
+		CustomerOwnedIp: aws.String("192.168.0.10"),
+		IpOwnerId:       aws.String("123456789012"),
+		PublicDnsName:   aws.String("ec2-54-0-0-10.compute-1.amazonaws.com"),
+		PublicIp:        aws.String("54.0.0.10"),
+	}
+	r.Attachment.AttachmentId = aws.String("eni-attach-0a1b2c3d")
+	r.Attachment.AttachTime = aws.Time(time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC))
+	r.Attachment.InstanceId = aws.String("i-0a1b2c3d4e5f6a7b8")
+	r.Attachment.InstanceOwnerId = aws.String("123456789012")
+	r.Attachment.Status = types.AttachmentStatusAttached
+	r.Groups = []types.GroupIdentifier{{GroupId: aws.String("sg-0a1b2c3d"), GroupName: aws.String("default")}}
+	r.Ipv6Addresses = []types.NetworkInterfaceIpv6Address{{Ipv6Address: aws.String("2600:1f18:0:1::10")}}
+	r.PrivateIpAddresses = []types.NetworkInterfacePrivateIpAddress{{
+		Association:      r.Association,
+		Primary:          true,
+		PrivateDnsName:   r.PrivateDnsName,
+		PrivateIpAddress: r.PrivateIpAddress,
+	}}
+	r.TagSet = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []types.NetworkInterface{r},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2NetworkInterfaces(t *testing.T) {
+	awsTestHelper(t, Ec2NetworkInterfaces(), buildEc2NetworkInterfaces)
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2PlacementGroups() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_placement_groups",
+		Resolver:     fetchEc2PlacementGroups,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2PlacementGroupArn,
+			},
+			{
+				Name: "group_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "group_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "partition_count",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "strategy",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2PlacementGroupTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2PlacementGroups(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{}, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.PlacementGroups
+	return nil
+}
+func resolveEc2PlacementGroupArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.PlacementGroup)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "placement-group", aws.ToString(r.GroupName), cl.Region, cl.AccountID))
+}
+func resolveEc2PlacementGroupTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.PlacementGroup)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
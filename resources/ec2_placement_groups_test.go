@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2PlacementGroups(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	g := types.PlacementGroup{}
+	err := faker.FakeData(&g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.GroupId = aws.String("pg-0a1b2c3d4e5f6a7b8")
+	// the arn is built from the name of the group
+	g.GroupName = aws.String("test-partitions")
+	g.Strategy = types.PlacementStrategyPartition
+	g.PartitionCount = 3
+	g.State = types.PlacementGroupStateAvailable
+	g.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribePlacementGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribePlacementGroupsOutput{
+			PlacementGroups: []types.PlacementGroup{g},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2PlacementGroups(t *testing.T) {
+	awsTestHelper(t, Ec2PlacementGroups(), buildEc2PlacementGroups)
+}
@@ -54,13 +54,10 @@ func fetchEc2RegionalConfig(ctx context.Context, meta schema.ClientMeta, _ *sche
 	}
 	regionalConfig.EbsDefaultKmsKeyId = resp.KmsKeyId
 
-	ebsResp, err := svc.GetEbsEncryptionByDefault(ctx, &ec2.GetEbsEncryptionByDefaultInput{}, func(options *ec2.Options) {
-		options.Region = c.Region
-	})
+	regionalConfig.EbsEncryptionEnabledByDefault, err = getEc2EbsEncryptionByDefault(ctx, c)
 	if err != nil {
 		return err
 	}
-	regionalConfig.EbsEncryptionEnabledByDefault = ebsResp.EbsEncryptionByDefault
 	res <- regionalConfig
 	return nil
 }
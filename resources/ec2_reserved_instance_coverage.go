@@ -0,0 +1,361 @@
+package resources
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// reservedInstanceCoverage is the coverage of the running instances of an instance type, tenancy and platform
+type reservedInstanceCoverage struct {
+	InstanceType                  string
+	InstanceTenancy               string
+	Platform                      string
+	RunningInstanceCount          int
+	ZonalReservedInstanceCount    int
+	RegionalReservedInstanceCount int
+	CoveredInstanceCount          int
+	UncoveredInstanceCount        int
+	UnusedReservedInstanceCount   int
+}
+
+// reservationKey is what an instance must match to be covered by a reservation, the availability zone is only set for
+// zonal reservations
+type reservationKey struct {
+	InstanceType     string
+	InstanceTenancy  string
+	Platform         string
+	AvailabilityZone string
+}
+
+func (k reservationKey) regional() reservationKey {
+	k.AvailabilityZone = ""
+	return k
+}
+
+func Ec2ReservedInstanceCoverage() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_reserved_instance_coverage",
+		Description:  "Running on-demand instances of the region matched against active reserved instances by instance type, tenancy, platform and, for zonal reservations, availability zone. Regional Linux/UNIX reservations of default tenancy also cover other sizes of their instance family by normalization factor",
+		Resolver:     fetchEc2ReservedInstanceCoverage,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "instance_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_tenancy",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "platform",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "running_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "zonal_reserved_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "regional_reserved_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "covered_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "uncovered_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "unused_reserved_instance_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name:     "coverage_percentage",
+				Type:     schema.TypeFloat,
+				Resolver: resolveEc2ReservedInstanceCoveragePercentage,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2ReservedInstanceCoverage(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	instances, err := listEc2Instances(ctx, c)
+	if err != nil {
+		return err
+	}
+	// reservations only apply to running on-demand instances
+	var onDemand []types.Instance
+	var imageIds []string
+	seen := make(map[string]bool)
+	for _, instance := range instances {
+		if instance.State == nil || instance.State.Name != types.InstanceStateNameRunning || instance.InstanceLifecycle != "" {
+			continue
+		}
+		onDemand = append(onDemand, instance)
+		if id := aws.ToString(instance.ImageId); id != "" && !seen[id] {
+			seen[id] = true
+			imageIds = append(imageIds, id)
+		}
+	}
+	// the platform details of instances are only returned for their images
+	images, err := describeEc2ImagesByID(ctx, c, imageIds, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	platformDetails := make(map[string]string, len(images))
+	for _, image := range images {
+		platformDetails[aws.ToString(image.ImageId)] = aws.ToString(image.PlatformDetails)
+	}
+	running := make(map[reservationKey]int)
+	for _, instance := range onDemand {
+		running[instanceReservationKey(instance, platformDetails[aws.ToString(instance.ImageId)])]++
+	}
+
+	output, err := c.Services().EC2.DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{
+		Filters: []types.Filter{{Name: aws.String("state"), Values: []string{string(types.ReservedInstanceStateActive)}}},
+	}, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	reserved := make(map[reservationKey]int)
+	for _, ri := range output.ReservedInstances {
+		key := reservationKey{
+			InstanceType:    string(ri.InstanceType),
+			InstanceTenancy: string(ri.InstanceTenancy),
+			Platform:        reservationPlatform(string(ri.ProductDescription)),
+		}
+		if ri.Scope == types.ScopeAvailabilityZone {
+			key.AvailabilityZone = aws.ToString(ri.AvailabilityZone)
+		}
+		reserved[key] += int(ri.InstanceCount)
+	}
+	res <- computeReservedInstanceCoverage(running, reserved)
+	return nil
+}
+
+// computeReservedInstanceCoverage applies zonal reservations to the instances of their availability zone first, then
+// regional reservations to the instances left in any availability zone, and finally what is left of size flexible
+// regional reservations to the other sizes of their instance family
+func computeReservedInstanceCoverage(running, reserved map[reservationKey]int) []reservedInstanceCoverage {
+	coverage := make(map[reservationKey]*reservedInstanceCoverage)
+	get := func(k reservationKey) *reservedInstanceCoverage {
+		k = k.regional()
+		if v, ok := coverage[k]; ok {
+			return v
+		}
+		v := &reservedInstanceCoverage{InstanceType: k.InstanceType, InstanceTenancy: k.InstanceTenancy, Platform: k.Platform}
+		coverage[k] = v
+		return v
+	}
+
+	uncovered := make(map[reservationKey]int)
+	for k, count := range running {
+		get(k).RunningInstanceCount += count
+		zonal := minInt(count, reserved[k])
+		get(k).CoveredInstanceCount += zonal
+		uncovered[k.regional()] += count - zonal
+	}
+	flexible := make(map[reservationKey]int)
+	for k, count := range reserved {
+		v := get(k)
+		if k.AvailabilityZone != "" {
+			v.ZonalReservedInstanceCount += count
+			v.UnusedReservedInstanceCount += count - minInt(count, running[k])
+			continue
+		}
+		v.RegionalReservedInstanceCount += count
+		regional := minInt(count, uncovered[k])
+		v.CoveredInstanceCount += regional
+		uncovered[k] -= regional
+		if _, _, ok := sizeFlexibleUnits(k); ok {
+			flexible[k] = count - regional
+			continue
+		}
+		v.UnusedReservedInstanceCount += count - regional
+	}
+	applySizeFlexibleReservations(uncovered, flexible, get)
+
+	result := make([]reservedInstanceCoverage, 0, len(coverage))
+	for _, v := range coverage {
+		v.UncoveredInstanceCount = v.RunningInstanceCount - v.CoveredInstanceCount
+		result = append(result, *v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.InstanceType != b.InstanceType {
+			return a.InstanceType < b.InstanceType
+		}
+		if a.InstanceTenancy != b.InstanceTenancy {
+			return a.InstanceTenancy < b.InstanceTenancy
+		}
+		return a.Platform < b.Platform
+	})
+	return result
+}
+
+// applySizeFlexibleReservations pools the unused reservations of each instance family in normalization units and
+// applies them to the uncovered instances of the family, smallest size first. An instance only counts as covered when
+// the units left cover it fully, and a reservation counts as used when any of its units were applied.
+func applySizeFlexibleReservations(uncovered, unused map[reservationKey]int, get func(reservationKey) *reservedInstanceCoverage) {
+	units := make(map[string]int)
+	reservations := make(map[string][]reservationKey)
+	for k, count := range unused {
+		family, n, _ := sizeFlexibleUnits(k)
+		units[family] += count * n
+		reservations[family] = append(reservations[family], k)
+	}
+	instances := make(map[string][]reservationKey)
+	for k := range uncovered {
+		if family, _, ok := sizeFlexibleUnits(k); ok {
+			instances[family] = append(instances[family], k)
+		}
+	}
+	for family, pool := range units {
+		left := pool
+		sortBySize(instances[family])
+		for _, k := range instances[family] {
+			_, n, _ := sizeFlexibleUnits(k)
+			covered := minInt(uncovered[k], left/n)
+			get(k).CoveredInstanceCount += covered
+			uncovered[k] -= covered
+			left -= covered * n
+		}
+		consumed := pool - left
+		sortBySize(reservations[family])
+		for _, k := range reservations[family] {
+			_, n, _ := sizeFlexibleUnits(k)
+			used := minInt(unused[k], (consumed+n-1)/n)
+			consumed -= used * n
+			if consumed < 0 {
+				consumed = 0
+			}
+			get(k).UnusedReservedInstanceCount += unused[k] - used
+		}
+	}
+}
+
+func sortBySize(keys []reservationKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		_, a, _ := sizeFlexibleUnits(keys[i])
+		_, b, _ := sizeFlexibleUnits(keys[j])
+		if a != b {
+			return a < b
+		}
+		return keys[i].InstanceType < keys[j].InstanceType
+	})
+}
+
+// sizeFlexibleUnits returns the instance family and the normalization factor of a regional Linux/UNIX key of default
+// tenancy, times four so a nano is one unit. ok is false for keys reservations of other sizes don't apply to.
+func sizeFlexibleUnits(k reservationKey) (family string, units int, ok bool) {
+	if k.AvailabilityZone != "" || k.Platform != "Linux/UNIX" || k.InstanceTenancy != string(types.TenancyDefault) {
+		return "", 0, false
+	}
+	parts := strings.SplitN(k.InstanceType, ".", 2)
+	if len(parts) != 2 {
+		return "", 0, false
+	}
+	family, size := parts[0], parts[1]
+	switch size {
+	case "nano":
+		return family, 1, true
+	case "micro":
+		return family, 2, true
+	case "small":
+		return family, 4, true
+	case "medium":
+		return family, 8, true
+	case "large":
+		return family, 16, true
+	case "xlarge":
+		return family, 32, true
+	}
+	// i.e 2xlarge, metal sizes have a factor depending on the family and aren't matched by other sizes here
+	if n, err := strconv.Atoi(strings.TrimSuffix(size, "xlarge")); err == nil && strings.HasSuffix(size, "xlarge") && n > 0 {
+		return family, 32 * n, true
+	}
+	return "", 0, false
+}
+
+// instanceReservationKey returns the key of a running instance. The platform details of its image name the operating
+// system and licensed software like the product description of reservations, the platform of the instance only tells
+// windows instances apart and is used when the image was deregistered.
+func instanceReservationKey(instance types.Instance, platformDetails string) reservationKey {
+	platform := platformDetails
+	if platform == "" {
+		platform = string(instance.Platform)
+	}
+	key := reservationKey{
+		InstanceType:    string(instance.InstanceType),
+		InstanceTenancy: string(types.TenancyDefault),
+		Platform:        reservationPlatform(platform),
+	}
+	if instance.Placement != nil {
+		if instance.Placement.Tenancy != "" {
+			key.InstanceTenancy = string(instance.Placement.Tenancy)
+		}
+		key.AvailabilityZone = aws.ToString(instance.Placement.AvailabilityZone)
+	}
+	return key
+}
+
+// reservationPlatform normalizes the product description of a reservation and the platform of an instance
+func reservationPlatform(platform string) string {
+	platform = strings.TrimSuffix(platform, " (Amazon VPC)")
+	switch {
+	case platform == "":
+		return "Linux/UNIX"
+	case strings.EqualFold(platform, string(types.PlatformValuesWindows)):
+		return "Windows"
+	}
+	return platform
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func resolveEc2ReservedInstanceCoveragePercentage(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(reservedInstanceCoverage)
+	if r.RunningInstanceCount == 0 {
+		return nil
+	}
+	return resource.Set(c.Name, percentage(int64(r.CoveredInstanceCount), int64(r.RunningInstanceCount)))
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2ReservedInstanceCoverage(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	m.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeInstancesOutput{
+			Reservations: []types.Reservation{{Instances: []types.Instance{{
+				ImageId:      aws.String("ami-1"),
+				InstanceType: types.InstanceTypeM5Large,
+				Placement:    &types.Placement{AvailabilityZone: aws.String("us-east-1a"), Tenancy: types.TenancyDefault},
+				State:        &types.InstanceState{Name: types.InstanceStateNameRunning},
+			}}}},
+		}, nil)
+	m.EXPECT().DescribeImages(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeImagesOutput{
+			Images: []types.Image{{ImageId: aws.String("ami-1"), PlatformDetails: aws.String("Linux/UNIX")}},
+		}, nil)
+	m.EXPECT().DescribeReservedInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeReservedInstancesOutput{
+			ReservedInstances: []types.ReservedInstances{{
+				InstanceCount:      1,
+				InstanceTenancy:    types.TenancyDefault,
+				InstanceType:       types.InstanceTypeM5Large,
+				ProductDescription: "Linux/UNIX",
+				Scope:              types.ScopeRegional,
+			}},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2ReservedInstanceCoverage(t *testing.T) {
+	awsTestHelper(t, Ec2ReservedInstanceCoverage(), buildEc2ReservedInstanceCoverage)
+}
+
+func TestComputeReservedInstanceCoverage(t *testing.T) {
+	linux := reservationKey{InstanceType: "m5.large", InstanceTenancy: "default", Platform: "Linux/UNIX"}
+	zoneA, zoneB := linux, linux
+	zoneA.AvailabilityZone = "us-east-1a"
+	zoneB.AvailabilityZone = "us-east-1b"
+	windows := reservationKey{InstanceType: "m5.large", InstanceTenancy: "default", Platform: "Windows", AvailabilityZone: "us-east-1a"}
+
+	coverage := computeReservedInstanceCoverage(
+		map[reservationKey]int{zoneA: 3, zoneB: 2, windows: 1},
+		// two zonal reservations in us-east-1a, of which one is unused, and two regional reservations
+		map[reservationKey]int{zoneA: 4, linux: 2},
+	)
+	if len(coverage) != 2 {
+		t.Fatalf("expected coverage of linux and windows instances, got %+v", coverage)
+	}
+	expected := reservedInstanceCoverage{
+		InstanceType:                  "m5.large",
+		InstanceTenancy:               "default",
+		Platform:                      "Linux/UNIX",
+		RunningInstanceCount:          5,
+		ZonalReservedInstanceCount:    4,
+		RegionalReservedInstanceCount: 2,
+		CoveredInstanceCount:          5,
+		UncoveredInstanceCount:        0,
+		UnusedReservedInstanceCount:   1,
+	}
+	if coverage[0] != expected {
+		t.Errorf("unexpected linux coverage %+v", coverage[0])
+	}
+	if c := coverage[1]; c.Platform != "Windows" || c.RunningInstanceCount != 1 || c.UncoveredInstanceCount != 1 {
+		t.Errorf("unexpected windows coverage %+v", c)
+	}
+}
+
+func TestComputeReservedInstanceCoverageSizeFlexibility(t *testing.T) {
+	key := func(instanceType, platform, zone string) reservationKey {
+		return reservationKey{InstanceType: instanceType, InstanceTenancy: "default", Platform: platform, AvailabilityZone: zone}
+	}
+	coverage := computeReservedInstanceCoverage(
+		map[reservationKey]int{
+			key("m5.large", "Linux/UNIX", "us-east-1a"):  3,
+			key("m5.2xlarge", "Linux/UNIX", "us-east-1b"): 1,
+			key("m5.large", "Windows", "us-east-1a"):     1,
+		},
+		map[reservationKey]int{
+			// 96 units, of which the three m5.large take 48, using one and a half reservations
+			key("m5.xlarge", "Linux/UNIX", ""): 3,
+			// windows reservations only cover instances of their size
+			key("m5.xlarge", "Windows", ""): 1,
+		},
+	)
+	byType := make(map[string]reservedInstanceCoverage)
+	for _, c := range coverage {
+		byType[c.InstanceType+" "+c.Platform] = c
+	}
+	if len(byType) != 5 {
+		t.Fatalf("unexpected coverage %+v", coverage)
+	}
+	if c := byType["m5.large Linux/UNIX"]; c.CoveredInstanceCount != 3 || c.UncoveredInstanceCount != 0 {
+		t.Errorf("expected the m5.large instances to be covered by the m5.xlarge reservations, got %+v", c)
+	}
+	// the m5.2xlarge instance needs 64 units, only 48 are left
+	if c := byType["m5.2xlarge Linux/UNIX"]; c.CoveredInstanceCount != 0 || c.UncoveredInstanceCount != 1 {
+		t.Errorf("expected the m5.2xlarge instance to be uncovered, got %+v", c)
+	}
+	if c := byType["m5.xlarge Linux/UNIX"]; c.RegionalReservedInstanceCount != 3 || c.UnusedReservedInstanceCount != 1 {
+		t.Errorf("expected one unused m5.xlarge reservation, got %+v", c)
+	}
+	if c := byType["m5.large Windows"]; c.UncoveredInstanceCount != 1 {
+		t.Errorf("expected the windows instance to be uncovered, got %+v", c)
+	}
+	if c := byType["m5.xlarge Windows"]; c.UnusedReservedInstanceCount != 1 {
+		t.Errorf("expected the windows reservation to be unused, got %+v", c)
+	}
+}
+
+func TestInstanceReservationKey(t *testing.T) {
+	instance := types.Instance{InstanceType: types.InstanceTypeM5Large, Platform: types.PlatformValuesWindows}
+	if k := instanceReservationKey(instance, "Windows with SQL Server Standard"); k.Platform != "Windows with SQL Server Standard" {
+		t.Errorf("expected the platform details of the image, got %+v", k)
+	}
+	// the image was deregistered
+	if k := instanceReservationKey(instance, ""); k.Platform != "Windows" {
+		t.Errorf("expected the windows platform, got %+v", k)
+	}
+	if k := instanceReservationKey(types.Instance{InstanceType: types.InstanceTypeM5Large}, "Red Hat Enterprise Linux"); k.Platform != "Red Hat Enterprise Linux" {
+		t.Errorf("expected red hat, got %+v", k)
+	}
+}
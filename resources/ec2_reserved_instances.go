@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2ReservedInstances() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_reserved_instances",
+		Resolver:     fetchEc2ReservedInstances,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2ReservedInstanceArn,
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "currency_code",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "duration",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "end",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "fixed_price",
+				Type: schema.TypeFloat,
+			},
+			{
+				Name: "instance_count",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "instance_tenancy",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "offering_class",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "offering_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "product_description",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "reserved_instances_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "scope",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "start",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2ReservedInstanceTags,
+			},
+			{
+				Name: "usage_price",
+				Type: schema.TypeFloat,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_reserved_instance_recurring_charges",
+				Resolver: fetchEc2ReservedInstanceRecurringCharges,
+				Columns: []schema.Column{
+					{
+						Name:     "reserved_instance_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "amount",
+						Type: schema.TypeFloat,
+					},
+					{
+						Name: "frequency",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2ReservedInstances(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{}, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.ReservedInstances
+	return nil
+}
+func resolveEc2ReservedInstanceArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.ReservedInstances)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "reserved-instances", aws.ToString(r.ReservedInstancesId), cl.Region, cl.AccountID))
+}
+func resolveEc2ReservedInstanceTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.ReservedInstances)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2ReservedInstanceRecurringCharges(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.ReservedInstances)
+	if !ok {
+		return fmt.Errorf("expected types.ReservedInstances but got %T", parent.Item)
+	}
+	res <- r.RecurringCharges
+	return nil
+}
@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2ReservedInstances(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.ReservedInstances{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.ReservedInstancesId = aws.String("b847fa93-c736-4eae-bca1-e3147example")
+	r.InstanceType = types.InstanceTypeM5Large
+	r.InstanceTenancy = types.TenancyDefault
+	r.ProductDescription = types.RIProductDescription("Linux/UNIX (Amazon VPC)")
+	r.Scope = types.ScopeAvailabilityZone
+	r.AvailabilityZone = aws.String("us-east-1a")
+	r.State = types.ReservedInstanceStateActive
+	r.OfferingClass = types.OfferingClassTypeStandard
+	r.OfferingType = types.OfferingTypeValuesPartialUpfront
+	r.CurrencyCode = types.CurrencyCodeValuesUsd
+	r.InstanceCount = 2
+	// a year, in seconds
+	r.Duration = 31536000
+	start := time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)
+	r.Start = aws.Time(start)
+	r.End = aws.Time(start.AddDate(1, 0, 0))
+	r.RecurringCharges = []types.RecurringCharge{{Amount: 0.034, Frequency: types.RecurringChargeFrequencyHourly}}
+	r.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeReservedInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeReservedInstancesOutput{
+			ReservedInstances: []types.ReservedInstances{r},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2ReservedInstances(t *testing.T) {
+	awsTestHelper(t, Ec2ReservedInstances(), buildEc2ReservedInstances)
+}
@@ -0,0 +1,404 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const (
+	securityGroupReferenceInstance         = "instance"
+	securityGroupReferenceNetworkInterface = "network_interface"
+	securityGroupReferenceLoadBalancer     = "elbv2_load_balancer"
+	securityGroupReferenceRdsInstance      = "rds_db_instance"
+	securityGroupReferenceRdsCluster       = "rds_db_cluster"
+	securityGroupReferenceRedshiftCluster  = "redshift_cluster"
+	securityGroupReferenceEksCluster       = "eks_cluster"
+	securityGroupReferenceLambdaFunction   = "lambda_function"
+	securityGroupReferenceVpcEndpoint      = "vpc_endpoint"
+	securityGroupReferenceSecurityGroup    = "security_group"
+)
+
+// securityGroupReference is a resource using a security group, or a security group with a rule referencing it
+type securityGroupReference struct {
+	GroupId     string
+	Kind        string
+	ResourceId  string
+	ResourceArn string
+}
+
+// securityGroupUsage is a security group with the resources referencing it
+type securityGroupUsage struct {
+	types.SecurityGroup
+	References []securityGroupReference
+	// IsUnused is set when no resource uses the group, rules of other groups may still reference it. It is nil for
+	// groups without references when the references of a service couldn't be listed.
+	IsUnused *bool
+	// IsDefaultVpcSgWithRules is set for the default group of a VPC allowing any traffic
+	IsDefaultVpcSgWithRules bool
+}
+
+// securityGroupReferenceSource lists the security group references of a service
+type securityGroupReferenceSource struct {
+	service string
+	list    func(ctx context.Context, c *client.Client) ([]securityGroupReference, error)
+}
+
+var securityGroupReferenceSources = []securityGroupReferenceSource{
+	{"ec2 network interfaces", listNetworkInterfaceSecurityGroupReferences},
+	{"ec2 vpc endpoints", listVpcEndpointSecurityGroupReferences},
+	{"elbv2", listLoadBalancerSecurityGroupReferences},
+	{"rds", listRdsSecurityGroupReferences},
+	{"redshift", listRedshiftSecurityGroupReferences},
+	{"eks", listEksSecurityGroupReferences},
+	{"lambda", listLambdaSecurityGroupReferences},
+}
+
+func Ec2SecurityGroupUsages() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_security_group_usages",
+		Description:  "Security groups with the resources and the rules of other security groups referencing them",
+		Resolver:     fetchEc2SecurityGroupUsages,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2SecurityGroupUsageArn,
+			},
+			{
+				Name: "group_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "group_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "vpc_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:        "is_unused",
+				Type:        schema.TypeBool,
+				Description: "True if no resource uses the security group, null when it has no references but the resources of a service couldn't be listed",
+			},
+			{
+				Name: "is_default_vpc_sg_with_rules",
+				Type: schema.TypeBool,
+			},
+			{
+				Name:     "reference_count",
+				Type:     schema.TypeInt,
+				Resolver: resolveEc2SecurityGroupUsageReferenceCount,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_security_group_usage_references",
+				Resolver: fetchEc2SecurityGroupUsageReferences,
+				Columns: []schema.Column{
+					{
+						Name:     "security_group_usage_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "kind",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "resource_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "resource_arn",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2SecurityGroupUsages(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	groups, err := listEc2SecurityGroups(ctx, c)
+	if err != nil {
+		return err
+	}
+	references := securityGroupRuleReferences(c, groups)
+	complete := true
+	for _, source := range securityGroupReferenceSources {
+		sourceReferences, err := source.list(ctx, c)
+		if err != nil {
+			// the usages are still worth storing, but groups without references may be used by the skipped service
+			if client.IgnoreAccessDeniedServiceDisabled(err) {
+				c.Logger().Warn("skipping security group references", "service", source.service, "error", err)
+				complete = false
+				continue
+			}
+			return err
+		}
+		references = append(references, sourceReferences...)
+	}
+	res <- computeSecurityGroupUsages(groups, references, complete)
+	return nil
+}
+func resolveEc2SecurityGroupUsageArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(securityGroupUsage)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "security-group", aws.ToString(r.GroupId), cl.Region, cl.AccountID))
+}
+func resolveEc2SecurityGroupUsageReferenceCount(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(securityGroupUsage)
+	return resource.Set(c.Name, int32(len(r.References)))
+}
+func fetchEc2SecurityGroupUsageReferences(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(securityGroupUsage)
+	if !ok {
+		return fmt.Errorf("expected securityGroupUsage but got %T", parent.Item)
+	}
+	res <- r.References
+	return nil
+}
+
+// computeSecurityGroupUsages groups the references by security group and flags the unused groups and the default
+// groups with rules. Groups without references are only flagged unused when the references of every service are
+// complete.
+func computeSecurityGroupUsages(groups []types.SecurityGroup, references []securityGroupReference, complete bool) []securityGroupUsage {
+	byGroup := make(map[string][]securityGroupReference)
+	for _, r := range references {
+		byGroup[r.GroupId] = append(byGroup[r.GroupId], r)
+	}
+	usages := make([]securityGroupUsage, 0, len(groups))
+	for _, g := range groups {
+		usage := securityGroupUsage{SecurityGroup: g, References: byGroup[aws.ToString(g.GroupId)]}
+		used := false
+		for _, r := range usage.References {
+			if r.Kind != securityGroupReferenceSecurityGroup {
+				used = true
+				break
+			}
+		}
+		if used || complete {
+			usage.IsUnused = aws.Bool(!used)
+		}
+		usage.IsDefaultVpcSgWithRules = aws.ToString(g.GroupName) == "default" && g.VpcId != nil &&
+			(len(g.IpPermissions) > 0 || len(g.IpPermissionsEgress) > 0)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// securityGroupRuleReferences returns the groups referenced by the rules of other groups
+func securityGroupRuleReferences(c *client.Client, groups []types.SecurityGroup) []securityGroupReference {
+	var references []securityGroupReference
+	for _, g := range groups {
+		referenced := make(map[string]bool)
+		for _, p := range append(append([]types.IpPermission{}, g.IpPermissions...), g.IpPermissionsEgress...) {
+			for _, pair := range p.UserIdGroupPairs {
+				id := aws.ToString(pair.GroupId)
+				if id == "" || id == aws.ToString(g.GroupId) || referenced[id] {
+					continue
+				}
+				referenced[id] = true
+				references = append(references, securityGroupReference{
+					GroupId:     id,
+					Kind:        securityGroupReferenceSecurityGroup,
+					ResourceId:  aws.ToString(g.GroupId),
+					ResourceArn: client.GenerateResourceARN("ec2", "security-group", aws.ToString(g.GroupId), c.Region, c.AccountID),
+				})
+			}
+		}
+	}
+	return references
+}
+
+// listNetworkInterfaceSecurityGroupReferences returns the groups of network interfaces, attributed to their instance
+// when attached to one. Interfaces managed by other services, such as load balancers or lambda functions, are
+// listed as network interfaces.
+func listNetworkInterfaceSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	interfaces, err := listEc2NetworkInterfaces(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, ni := range interfaces {
+		kind, resourceType, id := securityGroupReferenceNetworkInterface, "network-interface", aws.ToString(ni.NetworkInterfaceId)
+		if ni.Attachment != nil && ni.Attachment.InstanceId != nil {
+			kind, resourceType, id = securityGroupReferenceInstance, "instance", aws.ToString(ni.Attachment.InstanceId)
+		}
+		for _, g := range ni.Groups {
+			references = append(references, securityGroupReference{
+				GroupId:     aws.ToString(g.GroupId),
+				Kind:        kind,
+				ResourceId:  id,
+				ResourceArn: client.GenerateResourceARN("ec2", resourceType, id, c.Region, c.AccountID),
+			})
+		}
+	}
+	return references, nil
+}
+
+func listVpcEndpointSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	endpoints, err := listEc2VpcEndpoints(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, e := range endpoints {
+		for _, g := range e.Groups {
+			references = append(references, securityGroupReference{
+				GroupId:     aws.ToString(g.GroupId),
+				Kind:        securityGroupReferenceVpcEndpoint,
+				ResourceId:  aws.ToString(e.VpcEndpointId),
+				ResourceArn: client.GenerateResourceARN("ec2", "vpc-endpoint", aws.ToString(e.VpcEndpointId), c.Region, c.AccountID),
+			})
+		}
+	}
+	return references, nil
+}
+
+func listLoadBalancerSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	loadBalancers, err := listElbv2LoadBalancers(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, lb := range loadBalancers {
+		for _, id := range lb.SecurityGroups {
+			references = append(references, securityGroupReference{
+				GroupId:     id,
+				Kind:        securityGroupReferenceLoadBalancer,
+				ResourceId:  aws.ToString(lb.LoadBalancerName),
+				ResourceArn: aws.ToString(lb.LoadBalancerArn),
+			})
+		}
+	}
+	return references, nil
+}
+
+func listRdsSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	instances, err := listRdsInstances(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, i := range instances {
+		for _, g := range i.VpcSecurityGroups {
+			references = append(references, securityGroupReference{
+				GroupId:     aws.ToString(g.VpcSecurityGroupId),
+				Kind:        securityGroupReferenceRdsInstance,
+				ResourceId:  aws.ToString(i.DBInstanceIdentifier),
+				ResourceArn: aws.ToString(i.DBInstanceArn),
+			})
+		}
+	}
+
+	clusters, err := listRdsClusters(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		for _, g := range cluster.VpcSecurityGroups {
+			references = append(references, securityGroupReference{
+				GroupId:     aws.ToString(g.VpcSecurityGroupId),
+				Kind:        securityGroupReferenceRdsCluster,
+				ResourceId:  aws.ToString(cluster.DBClusterIdentifier),
+				ResourceArn: aws.ToString(cluster.DBClusterArn),
+			})
+		}
+	}
+	return references, nil
+}
+
+func listRedshiftSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	clusters, err := listRedshiftClusters(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, cluster := range clusters {
+		id := aws.ToString(cluster.ClusterIdentifier)
+		for _, g := range cluster.VpcSecurityGroups {
+			references = append(references, securityGroupReference{
+				GroupId:     aws.ToString(g.VpcSecurityGroupId),
+				Kind:        securityGroupReferenceRedshiftCluster,
+				ResourceId:  id,
+				ResourceArn: fmt.Sprintf("arn:%s:redshift:%s:%s:cluster:%s", client.RegionPartition(c.Region), c.Region, c.AccountID, id),
+			})
+		}
+	}
+	return references, nil
+}
+
+// listEksSecurityGroupReferences returns the additional security groups of the clusters and the cluster security
+// groups created by eks
+func listEksSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	clusters, err := listEksClusters(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.ResourcesVpcConfig == nil {
+			continue
+		}
+		vpcConfig := cluster.ResourcesVpcConfig
+		ids := vpcConfig.SecurityGroupIds
+		if vpcConfig.ClusterSecurityGroupId != nil {
+			ids = append(ids, aws.ToString(vpcConfig.ClusterSecurityGroupId))
+		}
+		for _, id := range ids {
+			references = append(references, securityGroupReference{
+				GroupId:     id,
+				Kind:        securityGroupReferenceEksCluster,
+				ResourceId:  aws.ToString(cluster.Name),
+				ResourceArn: aws.ToString(cluster.Arn),
+			})
+		}
+	}
+	return references, nil
+}
+
+func listLambdaSecurityGroupReferences(ctx context.Context, c *client.Client) ([]securityGroupReference, error) {
+	functions, err := listLambdaFunctions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var references []securityGroupReference
+	for _, f := range functions {
+		if f.VpcConfig == nil {
+			continue
+		}
+		for _, id := range f.VpcConfig.SecurityGroupIds {
+			references = append(references, securityGroupReference{
+				GroupId:     id,
+				Kind:        securityGroupReferenceLambdaFunction,
+				ResourceId:  aws.ToString(f.FunctionName),
+				ResourceArn: aws.ToString(f.FunctionArn),
+			})
+		}
+	}
+	return references, nil
+}
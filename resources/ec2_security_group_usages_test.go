@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/smithy-go"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+)
+
+func buildEc2SecurityGroupUsages(t *testing.T, ctrl *gomock.Controller) client.Services {
+	ec2Client := mocks.NewMockEc2Client(ctrl)
+	ec2Client.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []types.SecurityGroup{
+				{GroupId: aws.String("sg-1"), GroupName: aws.String("default"), VpcId: aws.String("vpc-1")},
+				{GroupId: aws.String("sg-2"), GroupName: aws.String("web"), VpcId: aws.String("vpc-1")},
+			},
+		}, nil)
+	ec2Client.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []types.NetworkInterface{{
+				NetworkInterfaceId: aws.String("eni-1"),
+				Attachment:         &types.NetworkInterfaceAttachment{InstanceId: aws.String("i-1")},
+				Groups:             []types.GroupIdentifier{{GroupId: aws.String("sg-2")}},
+			}},
+		}, nil)
+	ec2Client.EXPECT().DescribeVpcEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcEndpointsOutput{}, nil)
+
+	elbv2Client := mocks.NewMockElbV2Client(ctrl)
+	elbv2Client.EXPECT().DescribeLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&elbv2.DescribeLoadBalancersOutput{
+			LoadBalancers: []elbv2types.LoadBalancer{{
+				LoadBalancerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/1"),
+				LoadBalancerName: aws.String("web"),
+				SecurityGroups:   []string{"sg-2"},
+			}},
+		}, nil)
+
+	rdsClient := mocks.NewMockRdsClient(ctrl)
+	rdsClient.EXPECT().DescribeDBInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&rds.DescribeDBInstancesOutput{}, nil)
+	rdsClient.EXPECT().DescribeDBClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&rds.DescribeDBClustersOutput{}, nil)
+
+	redshiftClient := mocks.NewMockRedshiftClient(ctrl)
+	redshiftClient.EXPECT().DescribeClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&redshift.DescribeClustersOutput{}, nil)
+
+	eksClient := mocks.NewMockEksClient(ctrl)
+	eksClient.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&eks.ListClustersOutput{Clusters: []string{"cluster"}}, nil)
+	eksClient.EXPECT().DescribeCluster(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&eks.DescribeClusterOutput{
+			Cluster: &ekstypes.Cluster{
+				Arn:                aws.String("arn:aws:eks:us-east-1:123456789012:cluster/cluster"),
+				Name:               aws.String("cluster"),
+				ResourcesVpcConfig: &ekstypes.VpcConfigResponse{ClusterSecurityGroupId: aws.String("sg-2")},
+			},
+		}, nil)
+
+	lambdaClient := mocks.NewMockLambdaClient(ctrl)
+	lambdaClient.EXPECT().ListFunctions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&lambda.ListFunctionsOutput{
+			Functions: []lambdatypes.FunctionConfiguration{{
+				FunctionArn:  aws.String("arn:aws:lambda:us-east-1:123456789012:function:f"),
+				FunctionName: aws.String("f"),
+				VpcConfig:    &lambdatypes.VpcConfigResponse{SecurityGroupIds: []string{"sg-2"}},
+			}},
+		}, nil)
+
+	return client.Services{
+		EC2:      ec2Client,
+		ELBv2:    elbv2Client,
+		RDS:      rdsClient,
+		Redshift: redshiftClient,
+		Eks:      eksClient,
+		Lambda:   lambdaClient,
+	}
+}
+
+func TestEc2SecurityGroupUsages(t *testing.T) {
+	awsTestHelper(t, Ec2SecurityGroupUsages(), buildEc2SecurityGroupUsages)
+}
+
+func TestComputeSecurityGroupUsages(t *testing.T) {
+	allowAll := []types.IpPermission{{IpProtocol: aws.String("-1")}}
+	groups := []types.SecurityGroup{
+		{GroupId: aws.String("sg-default"), GroupName: aws.String("default"), VpcId: aws.String("vpc-1"), IpPermissionsEgress: allowAll},
+		{GroupId: aws.String("sg-app"), GroupName: aws.String("app"), VpcId: aws.String("vpc-1"), IpPermissions: []types.IpPermission{{
+			UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-db")}, {GroupId: aws.String("sg-app")}},
+		}}},
+		{GroupId: aws.String("sg-db"), GroupName: aws.String("db"), VpcId: aws.String("vpc-1")},
+		{GroupId: aws.String("sg-closed"), GroupName: aws.String("default"), VpcId: aws.String("vpc-2")},
+	}
+	c := client.NewAwsClient(nil, nil)
+	references := append(securityGroupRuleReferences(&c, groups), securityGroupReference{
+		GroupId: "sg-app", Kind: securityGroupReferenceInstance, ResourceId: "i-1",
+	})
+
+	usages := computeSecurityGroupUsages(groups, references, true)
+	if len(usages) != len(groups) {
+		t.Fatalf("expected %d usages, got %d", len(groups), len(usages))
+	}
+	byId := make(map[string]securityGroupUsage)
+	for _, u := range usages {
+		byId[aws.ToString(u.GroupId)] = u
+	}
+	if u := byId["sg-app"]; aws.ToBool(u.IsUnused) || len(u.References) != 1 {
+		t.Errorf("expected sg-app to be used by a single instance, got %+v", u.References)
+	}
+	// rules referencing a group don't make it used, and groups don't reference themselves
+	if u := byId["sg-db"]; !aws.ToBool(u.IsUnused) || len(u.References) != 1 || u.References[0].ResourceId != "sg-app" {
+		t.Errorf("expected sg-db to be unused and referenced by sg-app, got %+v", u.References)
+	}
+	if u := byId["sg-default"]; !aws.ToBool(u.IsUnused) || !u.IsDefaultVpcSgWithRules {
+		t.Errorf("expected sg-default to be an unused default group with rules")
+	}
+	if u := byId["sg-closed"]; u.IsDefaultVpcSgWithRules {
+		t.Errorf("expected sg-closed to be a default group without rules")
+	}
+}
+
+func TestFetchEc2SecurityGroupUsagesSkippedSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec2Client := mocks.NewMockEc2Client(ctrl)
+	ec2Client.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSecurityGroupsOutput{
+			SecurityGroups: []types.SecurityGroup{
+				{GroupId: aws.String("sg-1"), GroupName: aws.String("db"), VpcId: aws.String("vpc-1")},
+				{GroupId: aws.String("sg-2"), GroupName: aws.String("web"), VpcId: aws.String("vpc-1")},
+			},
+		}, nil)
+	// the network interfaces are listed once for both their table and the usages
+	ec2Client.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+		&ec2.DescribeNetworkInterfacesOutput{
+			NetworkInterfaces: []types.NetworkInterface{{
+				NetworkInterfaceId: aws.String("eni-1"),
+				Groups:             []types.GroupIdentifier{{GroupId: aws.String("sg-2")}},
+			}},
+		}, nil)
+	ec2Client.EXPECT().DescribeVpcEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+	elbv2Client := mocks.NewMockElbV2Client(ctrl)
+	elbv2Client.EXPECT().DescribeLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+	rdsClient := mocks.NewMockRdsClient(ctrl)
+	rdsClient.EXPECT().DescribeDBInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &smithy.GenericAPIError{Code: "AccessDenied"})
+	redshiftClient := mocks.NewMockRedshiftClient(ctrl)
+	redshiftClient.EXPECT().DescribeClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(&redshift.DescribeClustersOutput{}, nil)
+	eksClient := mocks.NewMockEksClient(ctrl)
+	eksClient.EXPECT().ListClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(&eks.ListClustersOutput{}, nil)
+	lambdaClient := mocks.NewMockLambdaClient(ctrl)
+	lambdaClient.EXPECT().ListFunctions(gomock.Any(), gomock.Any(), gomock.Any()).Return(&lambda.ListFunctionsOutput{}, nil)
+
+	c := client.NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("testAccount", "us-east-1", client.Services{
+		EC2:      ec2Client,
+		ELBv2:    elbv2Client,
+		RDS:      rdsClient,
+		Redshift: redshiftClient,
+		Eks:      eksClient,
+		Lambda:   lambdaClient,
+	})
+	rc := client.AccountRegionMultiplex(&c)[0].(*client.Client)
+
+	res := make(chan interface{}, 1)
+	if err := fetchEc2NetworkInterfaces(context.Background(), rc, nil, res); err != nil {
+		t.Fatal(err)
+	}
+	<-res
+	if err := fetchEc2SecurityGroupUsages(context.Background(), rc, nil, res); err != nil {
+		t.Fatal(err)
+	}
+	byId := make(map[string]securityGroupUsage)
+	for _, u := range (<-res).([]securityGroupUsage) {
+		byId[aws.ToString(u.GroupId)] = u
+	}
+	// sg-1 may be used by a database that couldn't be listed
+	if u := byId["sg-1"]; u.IsUnused != nil {
+		t.Errorf("expected the usage of sg-1 to be unknown, got %v", aws.ToBool(u.IsUnused))
+	}
+	if u := byId["sg-2"]; u.IsUnused == nil || *u.IsUnused {
+		t.Errorf("expected sg-2 to be used, got %+v", u)
+	}
+}
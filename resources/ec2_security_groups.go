@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -16,7 +17,7 @@ func Ec2SecurityGroups() *schema.Table {
 		Resolver:     fetchEc2SecurityGroups,
 		Multiplex:    client.AccountRegionMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		DeleteFilter: client.DeleteTargetFilter(client.DeleteAccountRegionFilter, "ec2", "security-group", "group_id"),
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -305,11 +306,17 @@ func Ec2SecurityGroups() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchEc2SecurityGroups(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeSecurityGroupsInput
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-
-	response, err := svc.DescribeSecurityGroups(ctx, &config, func(o *ec2.Options) {
+	if !c.Targeted() {
+		return c.SendPages(ctx, ec2SecurityGroupsListing(c), res)
+	}
+	ids := c.TargetedResourceIDs("ec2", "security-group")
+	if len(ids) == 0 {
+		return nil
+	}
+	// filters, unlike GroupIds, don't fail when a targeted group was deleted
+	config := ec2.DescribeSecurityGroupsInput{Filters: []types.Filter{{Name: aws.String("group-id"), Values: ids}}}
+	response, err := c.Services().EC2.DescribeSecurityGroups(ctx, &config, func(o *ec2.Options) {
 		o.Region = c.Region
 	})
 	if err != nil {
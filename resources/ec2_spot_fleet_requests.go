@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2SpotFleetRequests() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_spot_fleet_requests",
+		Resolver:     fetchEc2SpotFleetRequests,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2SpotFleetRequestArn,
+			},
+			{
+				Name: "activity_status",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "create_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "spot_fleet_request_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "spot_fleet_request_state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2SpotFleetRequestTags,
+			},
+			{
+				Name:     "allocation_strategy",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.AllocationStrategy"),
+			},
+			{
+				Name:     "excess_capacity_termination_policy",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.ExcessCapacityTerminationPolicy"),
+			},
+			{
+				Name:     "fulfilled_capacity",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.FulfilledCapacity"),
+			},
+			{
+				Name:     "iam_fleet_role",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.IamFleetRole"),
+			},
+			{
+				Name:     "instance_interruption_behavior",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.InstanceInterruptionBehavior"),
+			},
+			{
+				Name:     "instance_pools_to_use_count",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.InstancePoolsToUseCount"),
+			},
+			{
+				Name:     "launch_template_configs",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2SpotFleetRequestLaunchTemplateConfigs,
+			},
+			{
+				Name:     "on_demand_allocation_strategy",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.OnDemandAllocationStrategy"),
+			},
+			{
+				Name:     "on_demand_fulfilled_capacity",
+				Type:     schema.TypeFloat,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.OnDemandFulfilledCapacity"),
+			},
+			{
+				Name:     "on_demand_max_total_price",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.OnDemandMaxTotalPrice"),
+			},
+			{
+				Name:     "on_demand_target_capacity",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.OnDemandTargetCapacity"),
+			},
+			{
+				Name:     "replace_unhealthy_instances",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.ReplaceUnhealthyInstances"),
+			},
+			{
+				Name:     "spot_max_total_price",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.SpotMaxTotalPrice"),
+			},
+			{
+				Name:     "spot_price",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.SpotPrice"),
+			},
+			{
+				Name:     "target_capacity",
+				Type:     schema.TypeInt,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.TargetCapacity"),
+			},
+			{
+				Name:     "terminate_instances_with_expiration",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.TerminateInstancesWithExpiration"),
+			},
+			{
+				Name:     "type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.Type"),
+			},
+			{
+				Name:     "valid_from",
+				Type:     schema.TypeTimestamp,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.ValidFrom"),
+			},
+			{
+				Name:     "valid_until",
+				Type:     schema.TypeTimestamp,
+				Resolver: schema.PathResolver("SpotFleetRequestConfig.ValidUntil"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2SpotFleetRequests(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeSpotFleetRequestsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeSpotFleetRequests(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.SpotFleetRequestConfigs
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2SpotFleetRequestArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.SpotFleetRequestConfig)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "spot-fleet-request", aws.ToString(r.SpotFleetRequestId), cl.Region, cl.AccountID))
+}
+func resolveEc2SpotFleetRequestTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.SpotFleetRequestConfig)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func resolveEc2SpotFleetRequestLaunchTemplateConfigs(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.SpotFleetRequestConfig)
+	if r.SpotFleetRequestConfig == nil {
+		return nil
+	}
+	return resolveJSONColumn(resource, c, r.SpotFleetRequestConfig.LaunchTemplateConfigs)
+}
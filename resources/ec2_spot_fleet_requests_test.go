@@ -0,0 +1,68 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2SpotFleetRequests(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.SpotFleetRequestConfig{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SpotFleetRequestId = aws.String("sfr-8c2d1f0b-7b44-4b55-0a6e-6d9e4a1c8e21")
+	r.SpotFleetRequestState = types.BatchStateActive
+	r.ActivityStatus = types.ActivityStatusFulfilled
+	r.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	config := r.SpotFleetRequestConfig
+	config.IamFleetRole = aws.String("arn:aws:iam::123456789012:role/aws-ec2-spot-fleet-tagging-role")
+	config.AllocationStrategy = types.AllocationStrategyCapacityOptimized
+	config.OnDemandAllocationStrategy = types.OnDemandAllocationStrategyLowestPrice
+	config.ExcessCapacityTerminationPolicy = types.ExcessCapacityTerminationPolicyDefault
+	config.InstanceInterruptionBehavior = types.InstanceInterruptionBehaviorTerminate
+	config.Type = types.FleetTypeMaintain
+	config.TargetCapacity = 4
+	config.OnDemandTargetCapacity = 1
+	config.SpotPrice = aws.String("0.05")
+	start := time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)
+	config.ValidFrom = aws.Time(start)
+	config.ValidUntil = aws.Time(start.AddDate(0, 1, 0))
+	// the launch template configs are stored as json
+	for i := range config.LaunchTemplateConfigs {
+		config.LaunchTemplateConfigs[i].LaunchTemplateSpecification = &types.FleetLaunchTemplateSpecification{
+			LaunchTemplateId:   aws.String("lt-0a1b2c3d4e5f6a7b8"),
+			LaunchTemplateName: aws.String("test-template"),
+			Version:            aws.String("1"),
+		}
+		for j := range config.LaunchTemplateConfigs[i].Overrides {
+			config.LaunchTemplateConfigs[i].Overrides[j].InstanceType = types.InstanceTypeM5Large
+			config.LaunchTemplateConfigs[i].Overrides[j].SubnetId = aws.String("subnet-0a1b2c3d")
+			config.LaunchTemplateConfigs[i].Overrides[j].AvailabilityZone = aws.String("us-east-1a")
+		}
+	}
+
+	m.EXPECT().DescribeSpotFleetRequests(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSpotFleetRequestsOutput{
+			SpotFleetRequestConfigs: []types.SpotFleetRequestConfig{r},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2SpotFleetRequests(t *testing.T) {
+	awsTestHelper(t, Ec2SpotFleetRequests(), buildEc2SpotFleetRequests)
+}
@@ -0,0 +1,199 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2SpotInstanceRequests() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_spot_instance_requests",
+		Resolver:     fetchEc2SpotInstanceRequests,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2SpotInstanceRequestArn,
+			},
+			{
+				Name: "actual_block_hourly_price",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "availability_zone_group",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "block_duration_minutes",
+				Type: schema.TypeInt,
+			},
+			{
+				Name: "create_time",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name:     "fault_code",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Fault.Code"),
+			},
+			{
+				Name:     "fault_message",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Fault.Message"),
+			},
+			{
+				Name: "instance_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "instance_interruption_behavior",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "launch_group",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "launch_specification_ebs_optimized",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("LaunchSpecification.EbsOptimized"),
+			},
+			{
+				Name:     "launch_specification_iam_instance_profile_arn",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.IamInstanceProfile.Arn"),
+			},
+			{
+				Name:     "launch_specification_image_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.ImageId"),
+			},
+			{
+				Name:     "launch_specification_instance_type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.InstanceType"),
+			},
+			{
+				Name:     "launch_specification_key_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.KeyName"),
+			},
+			{
+				Name:     "launch_specification_availability_zone",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.Placement.AvailabilityZone"),
+			},
+			{
+				Name:     "launch_specification_subnet_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("LaunchSpecification.SubnetId"),
+			},
+			{
+				Name: "launched_availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "product_description",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "spot_instance_request_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "spot_price",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "status_code",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Status.Code"),
+			},
+			{
+				Name:     "status_message",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Status.Message"),
+			},
+			{
+				Name:     "status_update_time",
+				Type:     schema.TypeTimestamp,
+				Resolver: schema.PathResolver("Status.UpdateTime"),
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2SpotInstanceRequestTags,
+			},
+			{
+				Name: "type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "valid_from",
+				Type: schema.TypeTimestamp,
+			},
+			{
+				Name: "valid_until",
+				Type: schema.TypeTimestamp,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2SpotInstanceRequests(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeSpotInstanceRequestsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeSpotInstanceRequests(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.SpotInstanceRequests
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2SpotInstanceRequestArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.SpotInstanceRequest)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "spot-instances-request", aws.ToString(r.SpotInstanceRequestId), cl.Region, cl.AccountID))
+}
+func resolveEc2SpotInstanceRequestTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.SpotInstanceRequest)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2SpotInstanceRequests(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	r := types.SpotInstanceRequest{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SpotInstanceRequestId = aws.String("sir-0a1b2c3d")
+	r.InstanceId = aws.String("i-0a1b2c3d4e5f6a7b8")
+	r.State = types.SpotInstanceStateActive
+	r.Type = types.SpotInstanceTypeOneTime
+	r.InstanceInterruptionBehavior = types.InstanceInterruptionBehaviorTerminate
+	r.ProductDescription = types.RIProductDescription("Linux/UNIX")
+	r.SpotPrice = aws.String("0.05")
+	r.LaunchedAvailabilityZone = aws.String("us-east-1a")
+	r.Status = &types.SpotInstanceStatus{
+		Code:       aws.String("fulfilled"),
+		Message:    aws.String("Your spot request is fulfilled."),
+		UpdateTime: aws.Time(time.Date(2021, 10, 4, 0, 5, 0, 0, time.UTC)),
+	}
+	r.Fault = &types.SpotInstanceStateFault{
+		Code:    aws.String("capacity-not-available"),
+		Message: aws.String("There is no capacity available that matches your request."),
+	}
+	r.LaunchSpecification.ImageId = aws.String("ami-0a1b2c3d4e5f6a7b8")
+	r.LaunchSpecification.InstanceType = types.InstanceTypeM5Large
+	r.LaunchSpecification.KeyName = aws.String("test-key")
+	r.LaunchSpecification.SubnetId = aws.String("subnet-0a1b2c3d")
+	r.LaunchSpecification.IamInstanceProfile.Arn = aws.String("arn:aws:iam::123456789012:instance-profile/test")
+	r.LaunchSpecification.Placement.AvailabilityZone = aws.String("us-east-1a")
+	r.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeSpotInstanceRequests(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeSpotInstanceRequestsOutput{
+			SpotInstanceRequests: []types.SpotInstanceRequest{r},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2SpotInstanceRequests(t *testing.T) {
+	awsTestHelper(t, Ec2SpotInstanceRequests(), buildEc2SpotInstanceRequests)
+}
@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
+	"net"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -44,6 +44,16 @@ func Ec2Subnets() *schema.Table {
 				Name: "available_ip_address_count",
 				Type: schema.TypeInt,
 			},
+			{
+				Name:     "ip_address_count",
+				Type:     schema.TypeInt,
+				Resolver: resolveEc2SubnetIpAddressCount,
+			},
+			{
+				Name:     "ip_address_utilization",
+				Type:     schema.TypeFloat,
+				Resolver: resolveEc2SubnetIpAddressUtilization,
+			},
 			{
 				Name: "cidr_block",
 				Type: schema.TypeString,
@@ -132,23 +142,8 @@ func Ec2Subnets() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchEc2Subnets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeSubnetsInput
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-	for {
-		output, err := svc.DescribeSubnets(ctx, &config, func(options *ec2.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- output.Subnets
-		if aws.ToString(output.NextToken) == "" {
-			break
-		}
-		config.NextToken = output.NextToken
-	}
-	return nil
+	return c.SendPages(ctx, ec2SubnetsListing(c), res)
 }
 func resolveEc2subnetTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.Subnet)
@@ -163,3 +158,45 @@ func fetchEc2SubnetIpv6CidrBlockAssociationSets(ctx context.Context, meta schema
 	res <- r.Ipv6CidrBlockAssociationSet
 	return nil
 }
+func resolveEc2SubnetIpAddressCount(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.Subnet)
+	count, ok := subnetIpAddressCount(aws.ToString(r.CidrBlock))
+	if !ok {
+		return nil
+	}
+	return resource.Set(c.Name, count)
+}
+func resolveEc2SubnetIpAddressUtilization(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.Subnet)
+	count, ok := subnetIpAddressCount(aws.ToString(r.CidrBlock))
+	if !ok || count == 0 {
+		return nil
+	}
+	return resource.Set(c.Name, percentage(count-int64(r.AvailableIpAddressCount), count))
+}
+
+// subnetReservedIpAddresses is the number of addresses AWS reserves in every subnet
+const subnetReservedIpAddresses = 5
+
+// subnetIpAddressCount returns the number of addresses of an IPv4 subnet that can be assigned
+func subnetIpAddressCount(cidrBlock string) (int64, bool) {
+	count, ok := ipv4AddressCount(cidrBlock)
+	if !ok || count < subnetReservedIpAddresses {
+		return 0, false
+	}
+	return count - subnetReservedIpAddresses, true
+}
+
+// ipv4AddressCount returns the number of addresses of an IPv4 CIDR block
+func ipv4AddressCount(cidrBlock string) (int64, bool) {
+	_, network, err := net.ParseCIDR(cidrBlock)
+	if err != nil || network.IP.To4() == nil {
+		return 0, false
+	}
+	ones, bits := network.Mask.Size()
+	return int64(1) << (bits - ones), true
+}
+
+func percentage(part, total int64) float64 {
+	return float64(part) * 100 / float64(total)
+}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -194,6 +195,156 @@ func Ec2TransitGateways() *schema.Table {
 						Type: schema.TypeString,
 					},
 				},
+				Relations: []*schema.Table{
+					{
+						Name:     "aws_ec2_transit_gateway_route_table_associations",
+						Resolver: fetchEc2TransitGatewayRouteTableAssociations,
+						Columns: []schema.Column{
+							{
+								Name:     "transit_gateway_route_table_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "resource_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "resource_type",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "state",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "transit_gateway_attachment_id",
+								Type: schema.TypeString,
+							},
+						},
+					},
+					{
+						Name:     "aws_ec2_transit_gateway_route_table_prefix_list_references",
+						Resolver: fetchEc2TransitGatewayRouteTablePrefixListReferences,
+						Columns: []schema.Column{
+							{
+								Name:     "transit_gateway_route_table_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "blackhole",
+								Type: schema.TypeBool,
+							},
+							{
+								Name: "prefix_list_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "prefix_list_owner_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "state",
+								Type: schema.TypeString,
+							},
+							{
+								Name:     "attachment_resource_id",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("TransitGatewayAttachment.ResourceId"),
+							},
+							{
+								Name:     "attachment_resource_type",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("TransitGatewayAttachment.ResourceType"),
+							},
+							{
+								Name:     "transit_gateway_attachment_id",
+								Type:     schema.TypeString,
+								Resolver: schema.PathResolver("TransitGatewayAttachment.TransitGatewayAttachmentId"),
+							},
+						},
+					},
+					{
+						Name:     "aws_ec2_transit_gateway_route_table_propagations",
+						Resolver: fetchEc2TransitGatewayRouteTablePropagations,
+						Columns: []schema.Column{
+							{
+								Name:     "transit_gateway_route_table_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "resource_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "resource_type",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "state",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "transit_gateway_attachment_id",
+								Type: schema.TypeString,
+							},
+						},
+					},
+					{
+						Name:     "aws_ec2_transit_gateway_route_table_routes",
+						Resolver: fetchEc2TransitGatewayRouteTableRoutes,
+						Columns: []schema.Column{
+							{
+								Name:     "transit_gateway_route_table_id",
+								Type:     schema.TypeUUID,
+								Resolver: schema.ParentIdResolver,
+							},
+							{
+								Name: "destination_cidr_block",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "prefix_list_id",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "state",
+								Type: schema.TypeString,
+							},
+							{
+								Name: "type",
+								Type: schema.TypeString,
+							},
+						},
+						Relations: []*schema.Table{
+							{
+								Name:     "aws_ec2_transit_gateway_route_table_route_attachments",
+								Resolver: fetchEc2TransitGatewayRouteTableRouteAttachments,
+								Columns: []schema.Column{
+									{
+										Name:     "transit_gateway_route_table_route_id",
+										Type:     schema.TypeUUID,
+										Resolver: schema.ParentIdResolver,
+									},
+									{
+										Name: "resource_id",
+										Type: schema.TypeString,
+									},
+									{
+										Name: "resource_type",
+										Type: schema.TypeString,
+									},
+									{
+										Name: "transit_gateway_attachment_id",
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			{
 				Name:     "aws_ec2_transit_gateway_vpc_attachments",
@@ -413,7 +564,13 @@ func fetchEc2TransitGatewayAttachments(ctx context.Context, meta schema.ClientMe
 }
 
 func fetchEc2TransitGatewayRouteTables(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeTransitGatewayRouteTablesInput
+	r, ok := parent.Item.(types.TransitGateway)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGateway but got %T", parent.Item)
+	}
+	config := ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: []types.Filter{{Name: aws.String("transit-gateway-id"), Values: []string{aws.ToString(r.TransitGatewayId)}}},
+	}
 	c := meta.(*client.Client)
 	svc := c.Services().EC2
 	for {
@@ -545,3 +702,115 @@ func resolveEc2TransitGatewayMulticastDomainTags(ctx context.Context, meta schem
 	}
 	return resource.Set("tags", tags)
 }
+
+func fetchEc2TransitGatewayRouteTableAssociations(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.TransitGatewayRouteTable)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGatewayRouteTable but got %T", parent.Item)
+	}
+	config := ec2.GetTransitGatewayRouteTableAssociationsInput{TransitGatewayRouteTableId: r.TransitGatewayRouteTableId}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.GetTransitGatewayRouteTableAssociations(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.Associations
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func fetchEc2TransitGatewayRouteTablePrefixListReferences(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.TransitGatewayRouteTable)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGatewayRouteTable but got %T", parent.Item)
+	}
+	config := ec2.GetTransitGatewayPrefixListReferencesInput{TransitGatewayRouteTableId: r.TransitGatewayRouteTableId}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.GetTransitGatewayPrefixListReferences(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.TransitGatewayPrefixListReferences
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func fetchEc2TransitGatewayRouteTablePropagations(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.TransitGatewayRouteTable)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGatewayRouteTable but got %T", parent.Item)
+	}
+	config := ec2.GetTransitGatewayRouteTablePropagationsInput{TransitGatewayRouteTableId: r.TransitGatewayRouteTableId}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.GetTransitGatewayRouteTablePropagations(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.TransitGatewayRouteTablePropagations
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+
+// transitGatewayRouteSearchLimit is the maximum number of routes SearchTransitGatewayRoutes returns, it doesn't paginate
+const transitGatewayRouteSearchLimit = 1000
+
+// fetchEc2TransitGatewayRouteTableRoutes searches static and propagated routes separately, as the search requires a
+// filter and returns at most transitGatewayRouteSearchLimit routes per call
+func fetchEc2TransitGatewayRouteTableRoutes(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.TransitGatewayRouteTable)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGatewayRouteTable but got %T", parent.Item)
+	}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for _, routeType := range []types.TransitGatewayRouteType{types.TransitGatewayRouteTypeStatic, types.TransitGatewayRouteTypePropagated} {
+		output, err := svc.SearchTransitGatewayRoutes(ctx, &ec2.SearchTransitGatewayRoutesInput{
+			TransitGatewayRouteTableId: r.TransitGatewayRouteTableId,
+			Filters:                    []types.Filter{{Name: aws.String("type"), Values: []string{string(routeType)}}},
+			MaxResults:                 transitGatewayRouteSearchLimit,
+		}, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		if output.AdditionalRoutesAvailable {
+			meta.Logger().Warn("transit gateway route table has more routes than can be searched", "transit_gateway_route_table_id", aws.ToString(r.TransitGatewayRouteTableId), "type", routeType, "limit", transitGatewayRouteSearchLimit)
+		}
+		res <- output.Routes
+	}
+	return nil
+}
+
+func fetchEc2TransitGatewayRouteTableRouteAttachments(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.TransitGatewayRoute)
+	if !ok {
+		return fmt.Errorf("expected types.TransitGatewayRoute but got %T", parent.Item)
+	}
+	res <- r.TransitGatewayAttachments
+	return nil
+}
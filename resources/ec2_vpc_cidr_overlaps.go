@@ -0,0 +1,313 @@
+package resources
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const (
+	vpcCidrResourceVpc                  = "vpc"
+	vpcCidrResourceVpcPeeringConnection = "vpc_peering_connection"
+	vpcCidrResourceTransitGatewayRoute  = "transit_gateway_route"
+)
+
+// vpcCidr is an IPv4 CIDR block of a network: a VPC, the peer VPC of a peering connection or the destination of a
+// transit gateway route to an attachment that isn't a fetched VPC, such as a VPN or a VPC of another account
+type vpcCidr struct {
+	AccountID    string
+	Region       string
+	ResourceType string
+	ResourceId   string
+	VpcId        string
+	CidrBlock    string
+	network      *net.IPNet
+}
+
+type vpcCidrOverlap struct {
+	Vpc         vpcCidr
+	Overlapping vpcCidr
+}
+
+func Ec2VpcCidrOverlaps() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_vpc_cidr_overlaps",
+		Description:  "IPv4 CIDR blocks of the account's VPCs overlapping CIDR blocks of VPCs in any fetched account and region, of peered VPCs in other accounts and of networks attached to transit gateways",
+		Resolver:     fetchEc2VpcCidrOverlaps,
+		Multiplex:    client.AccountMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "vpc_region",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Vpc.Region"),
+			},
+			{
+				Name:     "vpc_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Vpc.VpcId"),
+			},
+			{
+				Name:     "cidr_block",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Vpc.CidrBlock"),
+			},
+			{
+				Name:     "overlapping_account_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.AccountID"),
+			},
+			{
+				Name:     "overlapping_region",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.Region"),
+			},
+			{
+				Name:     "overlapping_resource_type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.ResourceType"),
+			},
+			{
+				Name:     "overlapping_resource_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.ResourceId"),
+			},
+			{
+				Name:     "overlapping_vpc_id",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.VpcId"),
+			},
+			{
+				Name:     "overlapping_cidr_block",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Overlapping.CidrBlock"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2VpcCidrOverlaps(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	// the inventory spans every account, so it's collected once and shared by the clients of all accounts
+	inventory, err := c.Memo(ctx, "ec2.vpc_cidrs", func(ctx context.Context) (interface{}, error) {
+		return listVpcCidrs(ctx, c)
+	})
+	if err != nil {
+		return err
+	}
+	res <- findVpcCidrOverlaps(c.AccountID, inventory.([]vpcCidr))
+	return nil
+}
+
+// findVpcCidrOverlaps returns the overlaps of the VPC CIDR blocks of account with every other CIDR block
+func findVpcCidrOverlaps(account string, cidrs []vpcCidr) []vpcCidrOverlap {
+	var overlaps []vpcCidrOverlap
+	for _, vpc := range cidrs {
+		if vpc.AccountID != account || vpc.ResourceType != vpcCidrResourceVpc {
+			continue
+		}
+		for _, other := range cidrs {
+			if other.ResourceType == vpcCidrResourceVpc && other.AccountID == vpc.AccountID && other.VpcId == vpc.VpcId {
+				continue
+			}
+			if vpc.network.Contains(other.network.IP) || other.network.Contains(vpc.network.IP) {
+				overlaps = append(overlaps, vpcCidrOverlap{Vpc: vpc, Overlapping: other})
+			}
+		}
+	}
+	return overlaps
+}
+
+// listVpcCidrs lists the IPv4 CIDR blocks of every account and region. Regions where ec2 is disabled or denied are
+// skipped, so a single account doesn't prevent the others from being compared.
+func listVpcCidrs(ctx context.Context, c *client.Client) ([]vpcCidr, error) {
+	accounts := make(map[string]bool)
+	for _, account := range c.Accounts() {
+		accounts[account] = true
+	}
+	var cidrs, routes []vpcCidr
+	for _, account := range c.Accounts() {
+		for _, region := range c.Regions() {
+			cl := c.WithAccountIDAndRegion(account, region)
+			if cl.Services() == nil {
+				continue
+			}
+			vpcs, err := listVpcCidrsOfRegion(ctx, cl, accounts)
+			if err != nil {
+				if client.IgnoreAccessDeniedServiceDisabled(err) {
+					cl.Logger().Warn("skipping vpc cidrs", "error", err)
+					continue
+				}
+				return nil, err
+			}
+			cidrs = append(cidrs, vpcs...)
+			tgwRoutes, err := listTransitGatewayRouteCidrs(ctx, cl)
+			if err != nil {
+				if client.IgnoreAccessDeniedServiceDisabled(err) {
+					cl.Logger().Warn("skipping transit gateway route cidrs", "error", err)
+					continue
+				}
+				return nil, err
+			}
+			routes = append(routes, tgwRoutes...)
+		}
+	}
+	// routes to fetched VPCs are already compared as VPCs
+	vpcs := make(map[string]bool)
+	for _, cidr := range cidrs {
+		if cidr.ResourceType == vpcCidrResourceVpc {
+			vpcs[cidr.VpcId] = true
+		}
+	}
+	for _, route := range routes {
+		if !vpcs[route.VpcId] {
+			cidrs = append(cidrs, route)
+		}
+	}
+	return cidrs, nil
+}
+
+func listVpcCidrsOfRegion(ctx context.Context, c *client.Client, accounts map[string]bool) ([]vpcCidr, error) {
+	var cidrs []vpcCidr
+	svc := c.Services().EC2
+	var config ec2.DescribeVpcsInput
+	for {
+		output, err := svc.DescribeVpcs(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, vpc := range output.Vpcs {
+			for _, association := range vpc.CidrBlockAssociationSet {
+				if association.CidrBlockState != nil && association.CidrBlockState.State != types.VpcCidrBlockStateCodeAssociated {
+					continue
+				}
+				cidrs = appendVpcCidr(cidrs, vpcCidr{
+					AccountID:    c.AccountID,
+					Region:       c.Region,
+					ResourceType: vpcCidrResourceVpc,
+					ResourceId:   aws.ToString(vpc.VpcId),
+					VpcId:        aws.ToString(vpc.VpcId),
+					CidrBlock:    aws.ToString(association.CidrBlock),
+				})
+			}
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+
+	var peeringConfig ec2.DescribeVpcPeeringConnectionsInput
+	for {
+		output, err := svc.DescribeVpcPeeringConnections(ctx, &peeringConfig, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, pc := range output.VpcPeeringConnections {
+			if pc.Status == nil || pc.Status.Code != types.VpcPeeringConnectionStateReasonCodeActive {
+				continue
+			}
+			for _, peer := range []*types.VpcPeeringConnectionVpcInfo{pc.AccepterVpcInfo, pc.RequesterVpcInfo} {
+				// VPCs of fetched accounts are listed by their own account
+				if peer == nil || accounts[aws.ToString(peer.OwnerId)] {
+					continue
+				}
+				blocks := peer.CidrBlockSet
+				if len(blocks) == 0 {
+					blocks = []types.CidrBlock{{CidrBlock: peer.CidrBlock}}
+				}
+				for _, block := range blocks {
+					cidrs = appendVpcCidr(cidrs, vpcCidr{
+						AccountID:    aws.ToString(peer.OwnerId),
+						Region:       aws.ToString(peer.Region),
+						ResourceType: vpcCidrResourceVpcPeeringConnection,
+						ResourceId:   aws.ToString(pc.VpcPeeringConnectionId),
+						VpcId:        aws.ToString(peer.VpcId),
+						CidrBlock:    aws.ToString(block.CidrBlock),
+					})
+				}
+			}
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		peeringConfig.NextToken = output.NextToken
+	}
+	return cidrs, nil
+}
+
+// listTransitGatewayRouteCidrs lists the destinations of the transit gateway routes of the region, the VpcId of
+// routes to VPC attachments is set so routes to fetched VPCs can be dropped
+func listTransitGatewayRouteCidrs(ctx context.Context, c *client.Client) ([]vpcCidr, error) {
+	var cidrs []vpcCidr
+	svc := c.Services().EC2
+	var config ec2.DescribeTransitGatewayRouteTablesInput
+	for {
+		output, err := svc.DescribeTransitGatewayRouteTables(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, table := range output.TransitGatewayRouteTables {
+			routes, err := svc.SearchTransitGatewayRoutes(ctx, &ec2.SearchTransitGatewayRoutesInput{
+				TransitGatewayRouteTableId: table.TransitGatewayRouteTableId,
+				Filters:                    []types.Filter{{Name: aws.String("state"), Values: []string{string(types.TransitGatewayRouteStateActive)}}},
+				MaxResults:                 transitGatewayRouteSearchLimit,
+			}, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, route := range routes.Routes {
+				for _, attachment := range route.TransitGatewayAttachments {
+					cidr := vpcCidr{
+						AccountID:    c.AccountID,
+						Region:       c.Region,
+						ResourceType: vpcCidrResourceTransitGatewayRoute,
+						ResourceId:   aws.ToString(attachment.TransitGatewayAttachmentId),
+						CidrBlock:    aws.ToString(route.DestinationCidrBlock),
+					}
+					if attachment.ResourceType == types.TransitGatewayAttachmentResourceTypeVpc {
+						cidr.VpcId = aws.ToString(attachment.ResourceId)
+					}
+					cidrs = appendVpcCidr(cidrs, cidr)
+				}
+			}
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return cidrs, nil
+}
+
+// appendVpcCidr appends cidr if its block is a valid IPv4 CIDR, routes to prefix lists have no destination block
+func appendVpcCidr(cidrs []vpcCidr, cidr vpcCidr) []vpcCidr {
+	_, network, err := net.ParseCIDR(cidr.CidrBlock)
+	if err != nil || network.IP.To4() == nil {
+		return cidrs
+	}
+	cidr.network = network
+	return append(cidrs, cidr)
+}
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2VpcCidrOverlaps(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	m.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcsOutput{
+			Vpcs: []types.Vpc{
+				{VpcId: aws.String("vpc-1"), CidrBlockAssociationSet: []types.VpcCidrBlockAssociation{{CidrBlock: aws.String("10.0.0.0/16")}}},
+				{VpcId: aws.String("vpc-2"), CidrBlockAssociationSet: []types.VpcCidrBlockAssociation{{CidrBlock: aws.String("10.0.128.0/17")}}},
+			},
+		}, nil)
+	m.EXPECT().DescribeVpcPeeringConnections(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcPeeringConnectionsOutput{}, nil)
+	m.EXPECT().DescribeTransitGatewayRouteTables(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeTransitGatewayRouteTablesOutput{}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2VpcCidrOverlaps(t *testing.T) {
+	awsTestHelper(t, Ec2VpcCidrOverlaps(), buildEc2VpcCidrOverlaps)
+}
+
+func TestFindVpcCidrOverlaps(t *testing.T) {
+	var cidrs []vpcCidr
+	for _, c := range []vpcCidr{
+		{AccountID: "111111111111", ResourceType: vpcCidrResourceVpc, VpcId: "vpc-a", CidrBlock: "10.0.0.0/16"},
+		{AccountID: "111111111111", ResourceType: vpcCidrResourceVpc, VpcId: "vpc-a", CidrBlock: "10.1.0.0/16"},
+		{AccountID: "222222222222", ResourceType: vpcCidrResourceVpc, VpcId: "vpc-b", CidrBlock: "10.0.64.0/18"},
+		{AccountID: "333333333333", ResourceType: vpcCidrResourceVpcPeeringConnection, VpcId: "vpc-c", CidrBlock: "10.1.0.0/24"},
+		{AccountID: "111111111111", ResourceType: vpcCidrResourceVpc, VpcId: "vpc-d", CidrBlock: "192.168.0.0/16"},
+		{AccountID: "111111111111", ResourceType: vpcCidrResourceTransitGatewayRoute, CidrBlock: "172.16.0.0/12"},
+	} {
+		cidrs = appendVpcCidr(cidrs, c)
+	}
+
+	overlaps := findVpcCidrOverlaps("111111111111", cidrs)
+	if len(overlaps) != 2 {
+		t.Fatalf("expected 2 overlaps, got %d: %+v", len(overlaps), overlaps)
+	}
+	for _, o := range overlaps {
+		if o.Vpc.VpcId != "vpc-a" {
+			t.Errorf("unexpected overlapping vpc %s", o.Vpc.VpcId)
+		}
+		switch o.Vpc.CidrBlock {
+		case "10.0.0.0/16":
+			if o.Overlapping.VpcId != "vpc-b" {
+				t.Errorf("expected 10.0.0.0/16 to overlap vpc-b, got %s", o.Overlapping.VpcId)
+			}
+		case "10.1.0.0/16":
+			if o.Overlapping.ResourceType != vpcCidrResourceVpcPeeringConnection {
+				t.Errorf("expected 10.1.0.0/16 to overlap the peered vpc, got %s", o.Overlapping.ResourceType)
+			}
+		}
+	}
+}
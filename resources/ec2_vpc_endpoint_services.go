@@ -0,0 +1,300 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2VpcEndpointServices() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_vpc_endpoint_services",
+		Resolver:     fetchEc2VpcEndpointServices,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2VpcEndpointServiceArn,
+			},
+			{
+				Name: "acceptance_required",
+				Type: schema.TypeBool,
+			},
+			{
+				Name:     "allows_all_principals",
+				Type:     schema.TypeBool,
+				Resolver: resolveEc2VpcEndpointServiceAllowsAllPrincipals,
+			},
+			{
+				Name: "availability_zones",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "base_endpoint_dns_names",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "gateway_load_balancer_arns",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "manages_vpc_endpoints",
+				Type: schema.TypeBool,
+			},
+			{
+				Name: "network_load_balancer_arns",
+				Type: schema.TypeStringArray,
+			},
+			{
+				Name: "private_dns_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "private_dns_name_configuration_name",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PrivateDnsNameConfiguration.Name"),
+			},
+			{
+				Name:     "private_dns_name_configuration_state",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PrivateDnsNameConfiguration.State"),
+			},
+			{
+				Name:     "private_dns_name_configuration_type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PrivateDnsNameConfiguration.Type"),
+			},
+			{
+				Name:     "private_dns_name_configuration_value",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("PrivateDnsNameConfiguration.Value"),
+			},
+			{
+				Name: "service_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "service_name",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "service_state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "service_types",
+				Type:     schema.TypeStringArray,
+				Resolver: resolveEc2VpcEndpointServiceServiceTypes,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2VpcEndpointServiceTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_vpc_endpoint_service_connections",
+				Resolver: fetchEc2VpcEndpointServiceConnections,
+				Columns: []schema.Column{
+					{
+						Name:     "vpc_endpoint_service_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "creation_timestamp",
+						Type: schema.TypeTimestamp,
+					},
+					{
+						Name:     "dns_entries",
+						Type:     schema.TypeJSON,
+						Resolver: resolveEc2VpcEndpointServiceConnectionDnsEntries,
+					},
+					{
+						Name: "gateway_load_balancer_arns",
+						Type: schema.TypeStringArray,
+					},
+					{
+						Name: "network_load_balancer_arns",
+						Type: schema.TypeStringArray,
+					},
+					{
+						Name: "vpc_endpoint_id",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "vpc_endpoint_owner",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "vpc_endpoint_state",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_vpc_endpoint_service_permissions",
+				Resolver: fetchEc2VpcEndpointServicePermissions,
+				Columns: []schema.Column{
+					{
+						Name:     "vpc_endpoint_service_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "principal",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "principal_type",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+// wrappedServiceConfiguration holds the principals allowed to connect to an endpoint service, they're described by a
+// separate call
+type wrappedServiceConfiguration struct {
+	types.ServiceConfiguration
+	AllowedPrincipals []types.AllowedPrincipal
+}
+
+func fetchEc2VpcEndpointServices(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	var config ec2.DescribeVpcEndpointServiceConfigurationsInput
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeVpcEndpointServiceConfigurations(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		services := make([]wrappedServiceConfiguration, 0, len(output.ServiceConfigurations))
+		for _, s := range output.ServiceConfigurations {
+			principals, err := listEc2VpcEndpointServicePermissions(ctx, c, s.ServiceId)
+			if err != nil {
+				return err
+			}
+			services = append(services, wrappedServiceConfiguration{ServiceConfiguration: s, AllowedPrincipals: principals})
+		}
+		res <- services
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func listEc2VpcEndpointServicePermissions(ctx context.Context, c *client.Client, serviceId *string) ([]types.AllowedPrincipal, error) {
+	var principals []types.AllowedPrincipal
+	config := ec2.DescribeVpcEndpointServicePermissionsInput{ServiceId: serviceId}
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeVpcEndpointServicePermissions(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		principals = append(principals, output.AllowedPrincipals...)
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return principals, nil
+}
+func resolveEc2VpcEndpointServiceArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(wrappedServiceConfiguration)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "vpc-endpoint-service", aws.ToString(r.ServiceId), cl.Region, cl.AccountID))
+}
+// resolveEc2VpcEndpointServiceAllowsAllPrincipals reports whether any AWS principal can connect to the service
+func resolveEc2VpcEndpointServiceAllowsAllPrincipals(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedServiceConfiguration)
+	for _, p := range r.AllowedPrincipals {
+		if aws.ToString(p.Principal) == "*" {
+			return resource.Set(c.Name, true)
+		}
+	}
+	return resource.Set(c.Name, false)
+}
+func resolveEc2VpcEndpointServiceServiceTypes(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedServiceConfiguration)
+	serviceTypes := make([]string, 0, len(r.ServiceType))
+	for _, t := range r.ServiceType {
+		serviceTypes = append(serviceTypes, string(t.ServiceType))
+	}
+	return resource.Set(c.Name, serviceTypes)
+}
+func resolveEc2VpcEndpointServiceTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedServiceConfiguration)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2VpcEndpointServiceConnections(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(wrappedServiceConfiguration)
+	if !ok {
+		return fmt.Errorf("expected wrappedServiceConfiguration but got %T", parent.Item)
+	}
+	config := ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []types.Filter{{Name: aws.String("service-id"), Values: []string{aws.ToString(r.ServiceId)}}},
+	}
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	for {
+		output, err := svc.DescribeVpcEndpointConnections(ctx, &config, func(o *ec2.Options) {
+			o.Region = c.Region
+		})
+		if err != nil {
+			return err
+		}
+		res <- output.VpcEndpointConnections
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEc2VpcEndpointServiceConnectionDnsEntries(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.VpcEndpointConnection)
+	return resolveJSONColumn(resource, c, r.DnsEntries)
+}
+func fetchEc2VpcEndpointServicePermissions(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(wrappedServiceConfiguration)
+	if !ok {
+		return fmt.Errorf("expected wrappedServiceConfiguration but got %T", parent.Item)
+	}
+	res <- r.AllowedPrincipals
+	return nil
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2VpcEndpointServices(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	s := types.ServiceConfiguration{}
+	err := faker.FakeData(&s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.ServiceId = aws.String("vpce-svc-0a1b2c3d4e5f6a7b8")
+	s.ServiceName = aws.String("com.amazonaws.vpce.us-east-1.vpce-svc-0a1b2c3d4e5f6a7b8")
+	s.ServiceState = types.ServiceStateAvailable
+	s.ServiceType = []types.ServiceTypeDetail{{ServiceType: types.ServiceTypeInterface}}
+	s.AvailabilityZones = []string{"us-east-1a", "us-east-1b"}
+	s.BaseEndpointDnsNames = []string{"vpce-svc-0a1b2c3d4e5f6a7b8.us-east-1.vpce.amazonaws.com"}
+	s.NetworkLoadBalancerArns = []string{"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/test/0a1b2c3d4e5f6a7b"}
+	s.PrivateDnsName = aws.String("service.example.com")
+	s.PrivateDnsNameConfiguration = &types.PrivateDnsNameConfiguration{
+		Name:  aws.String("_0a1b2c3d4e5f6a7b8"),
+		State: types.DnsNameStateVerified,
+		Type:  aws.String("TXT"),
+		Value: aws.String("vpce:0a1b2c3d4e5f6a7b8"),
+	}
+	s.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+	m.EXPECT().DescribeVpcEndpointServiceConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcEndpointServiceConfigurationsOutput{
+			ServiceConfigurations: []types.ServiceConfiguration{s},
+		}, nil)
+
+	// the wildcard principal lets any AWS account connect to the service
+	m.EXPECT().DescribeVpcEndpointServicePermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcEndpointServicePermissionsOutput{
+			AllowedPrincipals: []types.AllowedPrincipal{
+				{Principal: aws.String("arn:aws:iam::123456789012:root"), PrincipalType: types.PrincipalTypeAccount},
+				{Principal: aws.String("*"), PrincipalType: types.PrincipalTypeAll},
+			},
+		}, nil)
+
+	e := types.VpcEndpointConnection{}
+	err = faker.FakeData(&e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.ServiceId = s.ServiceId
+	e.VpcEndpointId = aws.String("vpce-0a1b2c3d4e5f6a7b8")
+	e.VpcEndpointOwner = aws.String("210987654321")
+	e.VpcEndpointState = types.StateAvailable
+	e.NetworkLoadBalancerArns = s.NetworkLoadBalancerArns
+	e.DnsEntries = []types.DnsEntry{{
+		DnsName:      aws.String("vpce-0a1b2c3d4e5f6a7b8-abcdefgh.vpce-svc-0a1b2c3d4e5f6a7b8.us-east-1.vpce.amazonaws.com"),
+		HostedZoneId: aws.String("Z7HUB22UULQXV"),
+	}}
+	m.EXPECT().DescribeVpcEndpointConnections(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpcEndpointConnectionsOutput{
+			VpcEndpointConnections: []types.VpcEndpointConnection{e},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2VpcEndpointServices(t *testing.T) {
+	awsTestHelper(t, Ec2VpcEndpointServices(), buildEc2VpcEndpointServices)
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -144,23 +142,8 @@ func Ec2VpcEndpoints() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchEc2VpcEndpoints(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeVpcEndpointsInput
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-	for {
-		output, err := svc.DescribeVpcEndpoints(ctx, &config, func(o *ec2.Options) {
-			o.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- output.VpcEndpoints
-		if aws.ToString(output.NextToken) == "" {
-			break
-		}
-		config.NextToken = output.NextToken
-	}
-	return nil
+	return c.SendPages(ctx, ec2VpcEndpointsListing(c), res)
 }
 func resolveEc2vpcEndpointTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.VpcEndpoint)
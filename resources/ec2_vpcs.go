@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -61,6 +60,24 @@ func Ec2Vpcs() *schema.Table {
 				Name: "vpc_id",
 				Type: schema.TypeString,
 			},
+			{
+				Name: "ip_address_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "used_ip_address_count",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name:     "ip_address_utilization",
+				Type:     schema.TypeFloat,
+				Resolver: resolveEc2VpcIpAddressUtilization,
+			},
+			{
+				Name:     "subnet_ip_address_allocation",
+				Type:     schema.TypeFloat,
+				Resolver: resolveEc2VpcSubnetIpAddressAllocation,
+			},
 		},
 		Relations: []*schema.Table{
 			{
@@ -136,27 +153,65 @@ func Ec2Vpcs() *schema.Table {
 // ====================================================================================================================
 //                                               Table Resolver Functions
 // ====================================================================================================================
+// wrappedVpc holds the address usage of a VPC, summed over the subnets of the VPC
+type wrappedVpc struct {
+	types.Vpc
+	// IpAddressCount is the number of addresses of the VPC's associated IPv4 CIDR blocks
+	IpAddressCount int64
+	// UsedIpAddressCount is the number of addresses assigned in the VPC's subnets
+	UsedIpAddressCount int64
+	// SubnetIpAddressCount is the number of addresses of the VPC's subnets, including the reserved ones
+	SubnetIpAddressCount int64
+}
+
 func fetchEc2Vpcs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config ec2.DescribeVpcsInput
 	c := meta.(*client.Client)
-	svc := c.Services().EC2
-	for {
-		output, err := svc.DescribeVpcs(ctx, &config, func(options *ec2.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
+	subnetList, err := listEc2Subnets(ctx, c)
+	if err != nil {
+		return err
+	}
+	subnets := make(map[string]*wrappedVpc)
+	for _, subnet := range subnetList {
+		count, ok := ipv4AddressCount(aws.ToString(subnet.CidrBlock))
+		if !ok {
+			continue
+		}
+		usage, ok := subnets[aws.ToString(subnet.VpcId)]
+		if !ok {
+			usage = &wrappedVpc{}
+			subnets[aws.ToString(subnet.VpcId)] = usage
 		}
-		res <- output.Vpcs
-		if aws.ToString(output.NextToken) == "" {
-			break
+		usage.SubnetIpAddressCount += count
+		if assignable, ok := subnetIpAddressCount(aws.ToString(subnet.CidrBlock)); ok {
+			usage.UsedIpAddressCount += assignable - int64(subnet.AvailableIpAddressCount)
 		}
-		config.NextToken = output.NextToken
 	}
-	return nil
+
+	return c.EachPage(ctx, ec2VpcsListing(c), func(items interface{}) error {
+		list := items.([]types.Vpc)
+		vpcs := make([]wrappedVpc, 0, len(list))
+		for _, vpc := range list {
+			w := wrappedVpc{Vpc: vpc}
+			if usage, ok := subnets[aws.ToString(vpc.VpcId)]; ok {
+				w.UsedIpAddressCount = usage.UsedIpAddressCount
+				w.SubnetIpAddressCount = usage.SubnetIpAddressCount
+			}
+			for _, association := range vpc.CidrBlockAssociationSet {
+				if association.CidrBlockState != nil && association.CidrBlockState.State != types.VpcCidrBlockStateCodeAssociated {
+					continue
+				}
+				if count, ok := ipv4AddressCount(aws.ToString(association.CidrBlock)); ok {
+					w.IpAddressCount += count
+				}
+			}
+			vpcs = append(vpcs, w)
+		}
+		res <- vpcs
+		return nil
+	})
 }
 func resolveEc2vpcTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
-	r := resource.Item.(types.Vpc)
+	r := resource.Item.(wrappedVpc)
 	tags := map[string]*string{}
 	for _, t := range r.Tags {
 		tags[*t.Key] = t.Value
@@ -164,12 +219,26 @@ func resolveEc2vpcTags(ctx context.Context, meta schema.ClientMeta, resource *sc
 	return resource.Set("tags", tags)
 }
 func fetchEc2VpcCidrBlockAssociationSets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	r := parent.Item.(types.Vpc)
+	r := parent.Item.(wrappedVpc)
 	res <- r.CidrBlockAssociationSet
 	return nil
 }
 func fetchEc2VpcIpv6CidrBlockAssociationSets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	r := parent.Item.(types.Vpc)
+	r := parent.Item.(wrappedVpc)
 	res <- r.Ipv6CidrBlockAssociationSet
 	return nil
 }
+func resolveEc2VpcIpAddressUtilization(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedVpc)
+	if r.IpAddressCount == 0 {
+		return nil
+	}
+	return resource.Set(c.Name, percentage(r.UsedIpAddressCount, r.IpAddressCount))
+}
+func resolveEc2VpcSubnetIpAddressAllocation(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(wrappedVpc)
+	if r.IpAddressCount == 0 {
+		return nil
+	}
+	return resource.Set(c.Name, percentage(r.SubnetIpAddressCount, r.IpAddressCount))
+}
@@ -0,0 +1,371 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2VpnConnections() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_vpn_connections",
+		Resolver:     fetchEc2VpnConnections,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2VpnConnectionArn,
+			},
+			{
+				Name: "category",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "customer_gateway_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "enable_acceleration",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("Options.EnableAcceleration"),
+			},
+			{
+				Name:     "local_ipv4_network_cidr",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Options.LocalIpv4NetworkCidr"),
+			},
+			{
+				Name:     "local_ipv6_network_cidr",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Options.LocalIpv6NetworkCidr"),
+			},
+			{
+				Name:     "remote_ipv4_network_cidr",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Options.RemoteIpv4NetworkCidr"),
+			},
+			{
+				Name:     "remote_ipv6_network_cidr",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Options.RemoteIpv6NetworkCidr"),
+			},
+			{
+				Name:     "static_routes_only",
+				Type:     schema.TypeBool,
+				Resolver: schema.PathResolver("Options.StaticRoutesOnly"),
+			},
+			{
+				Name:     "tunnel_inside_ip_version",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Options.TunnelInsideIpVersion"),
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2VpnConnectionTags,
+			},
+			{
+				Name: "transit_gateway_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "vpn_connection_id",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "vpn_gateway_id",
+				Type: schema.TypeString,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_vpn_connection_routes",
+				Resolver: fetchEc2VpnConnectionRoutes,
+				Columns: []schema.Column{
+					{
+						Name:     "vpn_connection_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "destination_cidr_block",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "source",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "state",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_vpn_connection_tunnel_options",
+				Resolver: fetchEc2VpnConnectionTunnelOptions,
+				Columns: []schema.Column{
+					{
+						Name:     "vpn_connection_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "dpd_timeout_action",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "dpd_timeout_seconds",
+						Type: schema.TypeInt,
+					},
+					{
+						Name:     "ike_versions",
+						Type:     schema.TypeStringArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionIkeVersions,
+					},
+					{
+						Name: "outside_ip_address",
+						Type: schema.TypeString,
+					},
+					{
+						Name:     "phase1_dh_group_numbers",
+						Type:     schema.TypeIntArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase1DHGroupNumbers,
+					},
+					{
+						Name:     "phase1_encryption_algorithms",
+						Type:     schema.TypeStringArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase1EncryptionAlgorithms,
+					},
+					{
+						Name:     "phase1_integrity_algorithms",
+						Type:     schema.TypeStringArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase1IntegrityAlgorithms,
+					},
+					{
+						Name: "phase1_lifetime_seconds",
+						Type: schema.TypeInt,
+					},
+					{
+						Name:     "phase2_dh_group_numbers",
+						Type:     schema.TypeIntArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase2DHGroupNumbers,
+					},
+					{
+						Name:     "phase2_encryption_algorithms",
+						Type:     schema.TypeStringArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase2EncryptionAlgorithms,
+					},
+					{
+						Name:     "phase2_integrity_algorithms",
+						Type:     schema.TypeStringArray,
+						Resolver: resolveEc2VpnConnectionTunnelOptionPhase2IntegrityAlgorithms,
+					},
+					{
+						Name: "phase2_lifetime_seconds",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "rekey_fuzz_percentage",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "rekey_margin_time_seconds",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "replay_window_size",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "startup_action",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "tunnel_inside_cidr",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "tunnel_inside_ipv6_cidr",
+						Type: schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:     "aws_ec2_vpn_connection_vgw_telemetries",
+				Resolver: fetchEc2VpnConnectionVgwTelemetries,
+				Columns: []schema.Column{
+					{
+						Name:     "vpn_connection_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "accepted_route_count",
+						Type: schema.TypeInt,
+					},
+					{
+						Name: "certificate_arn",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "last_status_change",
+						Type: schema.TypeTimestamp,
+					},
+					{
+						Name: "outside_ip_address",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "status",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "status_message",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+// The customer gateway configuration and the tunnel pre-shared keys are secrets, they aren't stored.
+
+func fetchEc2VpnConnections(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribeVpnConnections(ctx, &ec2.DescribeVpnConnectionsInput{}, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.VpnConnections
+	return nil
+}
+func resolveEc2VpnConnectionArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.VpnConnection)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "vpn-connection", aws.ToString(r.VpnConnectionId), cl.Region, cl.AccountID))
+}
+func resolveEc2VpnConnectionTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.VpnConnection)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2VpnConnectionRoutes(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.VpnConnection)
+	if !ok {
+		return fmt.Errorf("expected types.VpnConnection but got %T", parent.Item)
+	}
+	res <- r.Routes
+	return nil
+}
+func fetchEc2VpnConnectionTunnelOptions(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.VpnConnection)
+	if !ok {
+		return fmt.Errorf("expected types.VpnConnection but got %T", parent.Item)
+	}
+	if r.Options == nil {
+		return nil
+	}
+	res <- r.Options.TunnelOptions
+	return nil
+}
+func resolveEc2VpnConnectionTunnelOptionIkeVersions(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]string, 0, len(r.IkeVersions))
+	for _, v := range r.IkeVersions {
+		values = append(values, aws.ToString(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase1DHGroupNumbers(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]int, 0, len(r.Phase1DHGroupNumbers))
+	for _, v := range r.Phase1DHGroupNumbers {
+		values = append(values, int(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase1EncryptionAlgorithms(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]string, 0, len(r.Phase1EncryptionAlgorithms))
+	for _, v := range r.Phase1EncryptionAlgorithms {
+		values = append(values, aws.ToString(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase1IntegrityAlgorithms(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]string, 0, len(r.Phase1IntegrityAlgorithms))
+	for _, v := range r.Phase1IntegrityAlgorithms {
+		values = append(values, aws.ToString(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase2DHGroupNumbers(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]int, 0, len(r.Phase2DHGroupNumbers))
+	for _, v := range r.Phase2DHGroupNumbers {
+		values = append(values, int(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase2EncryptionAlgorithms(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]string, 0, len(r.Phase2EncryptionAlgorithms))
+	for _, v := range r.Phase2EncryptionAlgorithms {
+		values = append(values, aws.ToString(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func resolveEc2VpnConnectionTunnelOptionPhase2IntegrityAlgorithms(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.TunnelOption)
+	values := make([]string, 0, len(r.Phase2IntegrityAlgorithms))
+	for _, v := range r.Phase2IntegrityAlgorithms {
+		values = append(values, aws.ToString(v.Value))
+	}
+	return resource.Set(c.Name, values)
+}
+func fetchEc2VpnConnectionVgwTelemetries(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.VpnConnection)
+	if !ok {
+		return fmt.Errorf("expected types.VpnConnection but got %T", parent.Item)
+	}
+	res <- r.VgwTelemetry
+	return nil
+}
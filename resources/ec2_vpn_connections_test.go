@@ -0,0 +1,86 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2VpnConnections(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	v := types.VpnConnection{}
+	err := faker.FakeData(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.VpnConnectionId = aws.String("vpn-0a1b2c3d4e5f6a7b8")
+	v.CustomerGatewayId = aws.String("cgw-0a1b2c3d4e5f6a7b8")
+	v.VpnGatewayId = aws.String("vgw-0a1b2c3d4e5f6a7b8")
+	v.TransitGatewayId = aws.String("tgw-0a1b2c3d4e5f6a7b8")
+	v.Category = aws.String("VPN")
+	v.Type = types.GatewayTypeIpsec1
+	v.State = types.VpnStateAvailable
+	v.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+	v.Routes = []types.VpnStaticRoute{{
+		DestinationCidrBlock: aws.String("192.168.0.0/16"),
+		Source:               types.VpnStaticRouteSourceStatic,
+		State:                types.VpnStateAvailable,
+	}}
+	v.VgwTelemetry = []types.VgwTelemetry{{
+		AcceptedRouteCount: 1,
+		CertificateArn:     aws.String("arn:aws:acm:us-east-1:123456789012:certificate/8c2d1f0b-7b44-4b55-0a6e-6d9e4a1c8e21"),
+		LastStatusChange:   aws.Time(time.Date(2021, 10, 4, 0, 0, 0, 0, time.UTC)),
+		OutsideIpAddress:   aws.String("203.0.113.10"),
+		Status:             types.TelemetryStatusUp,
+		StatusMessage:      aws.String("1 BGP ROUTES"),
+	}}
+
+	options := v.Options
+	options.LocalIpv4NetworkCidr = aws.String("0.0.0.0/0")
+	options.RemoteIpv4NetworkCidr = aws.String("10.0.0.0/16")
+	options.LocalIpv6NetworkCidr = aws.String("::/0")
+	options.RemoteIpv6NetworkCidr = aws.String("2600:1f18::/56")
+	options.TunnelInsideIpVersion = types.TunnelInsideIpVersionIpv4
+	// the proposals of each phase are stored as arrays
+	options.TunnelOptions = []types.TunnelOption{{
+		OutsideIpAddress:           aws.String("203.0.113.10"),
+		TunnelInsideCidr:           aws.String("169.254.10.0/30"),
+		TunnelInsideIpv6Cidr:       aws.String("fd00:10::/126"),
+		DpdTimeoutAction:           aws.String("clear"),
+		DpdTimeoutSeconds:          30,
+		StartupAction:              aws.String("add"),
+		Phase1LifetimeSeconds:      28800,
+		Phase2LifetimeSeconds:      3600,
+		RekeyFuzzPercentage:        100,
+		RekeyMarginTimeSeconds:     540,
+		ReplayWindowSize:           1024,
+		IkeVersions:                []types.IKEVersionsListValue{{Value: aws.String("ikev2")}},
+		Phase1DHGroupNumbers:       []types.Phase1DHGroupNumbersListValue{{Value: 14}},
+		Phase2DHGroupNumbers:       []types.Phase2DHGroupNumbersListValue{{Value: 14}},
+		Phase1EncryptionAlgorithms: []types.Phase1EncryptionAlgorithmsListValue{{Value: aws.String("AES256")}},
+		Phase2EncryptionAlgorithms: []types.Phase2EncryptionAlgorithmsListValue{{Value: aws.String("AES256")}},
+		Phase1IntegrityAlgorithms:  []types.Phase1IntegrityAlgorithmsListValue{{Value: aws.String("SHA2-256")}},
+		Phase2IntegrityAlgorithms:  []types.Phase2IntegrityAlgorithmsListValue{{Value: aws.String("SHA2-256")}},
+	}}
+
+	m.EXPECT().DescribeVpnConnections(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpnConnectionsOutput{
+			VpnConnections: []types.VpnConnection{v},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2VpnConnections(t *testing.T) {
+	awsTestHelper(t, Ec2VpnConnections(), buildEc2VpnConnections)
+}
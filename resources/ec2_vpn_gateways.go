@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2VpnGateways() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_vpn_gateways",
+		Resolver:     fetchEc2VpnGateways,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name:     "arn",
+				Type:     schema.TypeString,
+				Resolver: resolveEc2VpnGatewayArn,
+			},
+			{
+				Name: "amazon_side_asn",
+				Type: schema.TypeBigInt,
+			},
+			{
+				Name: "availability_zone",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "state",
+				Type: schema.TypeString,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEc2VpnGatewayTags,
+			},
+			{
+				Name: "type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "vpn_gateway_id",
+				Type: schema.TypeString,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:     "aws_ec2_vpn_gateway_vpc_attachments",
+				Resolver: fetchEc2VpnGatewayVpcAttachments,
+				Columns: []schema.Column{
+					{
+						Name:     "vpn_gateway_id",
+						Type:     schema.TypeUUID,
+						Resolver: schema.ParentIdResolver,
+					},
+					{
+						Name: "state",
+						Type: schema.TypeString,
+					},
+					{
+						Name: "vpc_id",
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2VpnGateways(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	output, err := svc.DescribeVpnGateways(ctx, &ec2.DescribeVpnGatewaysInput{}, func(o *ec2.Options) {
+		o.Region = c.Region
+	})
+	if err != nil {
+		return err
+	}
+	res <- output.VpnGateways
+	return nil
+}
+func resolveEc2VpnGatewayArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	r := resource.Item.(types.VpnGateway)
+	return resource.Set(c.Name, client.GenerateResourceARN("ec2", "vpn-gateway", aws.ToString(r.VpnGatewayId), cl.Region, cl.AccountID))
+}
+func resolveEc2VpnGatewayTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.VpnGateway)
+	tags := map[string]*string{}
+	for _, t := range r.Tags {
+		tags[*t.Key] = t.Value
+	}
+	return resource.Set(c.Name, tags)
+}
+func fetchEc2VpnGatewayVpcAttachments(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	r, ok := parent.Item.(types.VpnGateway)
+	if !ok {
+		return fmt.Errorf("expected types.VpnGateway but got %T", parent.Item)
+	}
+	res <- r.VpcAttachments
+	return nil
+}
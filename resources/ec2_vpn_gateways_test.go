@@ -0,0 +1,43 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2VpnGateways(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+
+	g := types.VpnGateway{}
+	err := faker.FakeData(&g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.VpnGatewayId = aws.String("vgw-0a1b2c3d4e5f6a7b8")
+	g.AmazonSideAsn = 64512
+	g.AvailabilityZone = aws.String("us-east-1a")
+	g.State = types.VpnStateAvailable
+	g.Type = types.GatewayTypeIpsec1
+	g.VpcAttachments = []types.VpcAttachment{{State: types.AttachmentStatusAttached, VpcId: aws.String("vpc-0a1b2c3d")}}
+	g.Tags = []types.Tag{{Key: aws.String("Name"), Value: aws.String("test")}}
+
+	m.EXPECT().DescribeVpnGateways(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeVpnGatewaysOutput{
+			VpnGateways: []types.VpnGateway{g},
+		}, nil)
+
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2VpnGateways(t *testing.T) {
+	awsTestHelper(t, Ec2VpnGateways(), buildEc2VpnGateways)
+}
@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -167,31 +166,8 @@ func EksClusters() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchEksClusters(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config eks.ListClustersInput
 	c := meta.(*client.Client)
-	svc := c.Services().Eks
-	for {
-		listClustersOutput, err := svc.ListClusters(ctx, &config, func(options *eks.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		for _, name := range listClustersOutput.Clusters {
-			describeClusterOutput, err := svc.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: &name}, func(options *eks.Options) {
-				options.Region = c.Region
-			})
-			if err != nil {
-				return err
-			}
-			res <- describeClusterOutput.Cluster
-		}
-		if listClustersOutput.NextToken == nil {
-			break
-		}
-		config.NextToken = listClustersOutput.NextToken
-	}
-	return nil
+	return c.SendPages(ctx, eksClustersListing(c), res)
 }
 func fetchEksClusterEncryptionConfigs(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	p := parent.Item.(*types.Cluster)
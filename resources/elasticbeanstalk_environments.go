@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
 	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -175,23 +173,8 @@ func ElasticbeanstalkEnvironments() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchElasticbeanstalkEnvironments(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config elasticbeanstalk.DescribeEnvironmentsInput
 	c := meta.(*client.Client)
-	svc := c.Services().ElasticBeanstalk
-	for {
-		response, err := svc.DescribeEnvironments(ctx, &config, func(options *elasticbeanstalk.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.Environments
-		if aws.ToString(response.NextToken) == "" {
-			break
-		}
-		config.NextToken = response.NextToken
-	}
-	return nil
+	return c.SendPages(ctx, elasticbeanstalkEnvironmentsListing(c), res)
 }
 func fetchElasticbeanstalkEnvironmentLinks(_ context.Context, _ schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	p := parent.Item.(types.EnvironmentDescription)
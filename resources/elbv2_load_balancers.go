@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -147,23 +145,8 @@ func Elbv2LoadBalancers() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchElbv2LoadBalancers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config elbv2.DescribeLoadBalancersInput
 	c := meta.(*client.Client)
-	svc := c.Services().ELBv2
-	for {
-		response, err := svc.DescribeLoadBalancers(ctx, &config, func(options *elbv2.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.LoadBalancers
-		if aws.ToString(response.NextMarker) == "" {
-			break
-		}
-		config.Marker = response.NextMarker
-	}
-	return nil
+	return c.SendPages(ctx, elbv2LoadBalancersListing(c), res)
 }
 func fetchElbv2LoadBalancerAvailabilityZones(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	p := parent.Item.(types.LoadBalancer)
@@ -0,0 +1,285 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// iacResourceKind maps a fetched table to the terraform resource type that manages it.
+type iacResourceKind struct {
+	Table         string
+	TerraformType string
+	// Global kinds are listed once per account instead of once per region
+	Global bool
+	List   func(ctx context.Context, c *client.Client) ([]iacFetchedResource, error)
+}
+
+type iacFetchedResource struct {
+	ID  string
+	ARN string
+}
+
+type iacOwnership struct {
+	ResourceRegion string
+	ResourceTable  string
+	ResourceId     string
+	ResourceArn    string
+	Managed        bool
+	Terraform      *client.TerraformResource
+}
+
+var iacResourceKinds = []iacResourceKind{
+	{Table: "aws_ec2_instances", TerraformType: "aws_instance", List: listIacEc2Instances},
+	{Table: "aws_ec2_ebs_volumes", TerraformType: "aws_ebs_volume", List: listIacEc2EbsVolumes},
+	{Table: "aws_ec2_security_groups", TerraformType: "aws_security_group", List: listIacEc2SecurityGroups},
+	{Table: "aws_ec2_vpcs", TerraformType: "aws_vpc", List: listIacEc2Vpcs},
+	{Table: "aws_ec2_subnets", TerraformType: "aws_subnet", List: listIacEc2Subnets},
+	{Table: "aws_lambda_functions", TerraformType: "aws_lambda_function", List: listIacLambdaFunctions},
+	{Table: "aws_kms_keys", TerraformType: "aws_kms_key", List: listIacKmsKeys},
+	{Table: "aws_s3_buckets", TerraformType: "aws_s3_bucket", Global: true, List: listIacS3Buckets},
+	{Table: "aws_iam_roles", TerraformType: "aws_iam_role", Global: true, List: listIacIamRoles},
+	{Table: "aws_iam_users", TerraformType: "aws_iam_user", Global: true, List: listIacIamUsers},
+}
+
+func IacOwnership() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_iac_ownership",
+		Description:  "Links fetched resources to the terraform resource managing them, based on the configured terraform_state_paths",
+		Resolver:     fetchIacOwnership,
+		Multiplex:    client.AccountMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:        "resource_region",
+				Type:        schema.TypeString,
+				Description: "The region of the resource, empty for global resources",
+			},
+			{
+				Name:        "resource_table",
+				Type:        schema.TypeString,
+				Description: "The table the resource is fetched into, i.e aws_ec2_instances",
+			},
+			{
+				Name:        "resource_id",
+				Type:        schema.TypeString,
+				Description: "The id of the resource as stored in its table, i.e instance_id or bucket name",
+			},
+			{
+				Name: "resource_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name:        "managed",
+				Type:        schema.TypeBool,
+				Description: "Whether the resource was found in one of the terraform state files",
+			},
+			{
+				Name:     "terraform_address",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Terraform.Address"),
+			},
+			{
+				Name:     "terraform_type",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Terraform.Type"),
+			},
+			{
+				Name:     "terraform_workspace",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Terraform.Workspace"),
+			},
+			{
+				Name:     "terraform_state_file",
+				Type:     schema.TypeString,
+				Resolver: schema.PathResolver("Terraform.StateFile"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchIacOwnership(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	state := c.TerraformState()
+	if state == nil {
+		c.Logger().Debug("no terraform_state_paths configured, skipping", "table", "aws_iac_ownership")
+		return nil
+	}
+	ownerships, err := resolveIacOwnerships(ctx, c, state)
+	if err != nil {
+		return err
+	}
+	res <- ownerships
+	return nil
+}
+
+// resolveIacOwnerships looks up the resources of every kind in the terraform state. The resources are read from the
+// listings shared with the tables fetching them, so the ownership covers the fetched resources without listing them
+// again.
+func resolveIacOwnerships(ctx context.Context, c *client.Client, state *client.TerraformState) ([]iacOwnership, error) {
+	var ownerships []iacOwnership
+	for _, kind := range iacResourceKinds {
+		regions := c.Regions()
+		if kind.Global {
+			regions = []string{""}
+		}
+		for _, region := range regions {
+			rc := c
+			if region != "" {
+				rc = c.WithRegion(region)
+			}
+			fetched, err := kind.List(ctx, rc)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range fetched {
+				tr := state.Lookup(kind.TerraformType, f.ID, f.ARN)
+				ownerships = append(ownerships, iacOwnership{
+					ResourceRegion: region,
+					ResourceTable:  kind.Table,
+					ResourceId:     f.ID,
+					ResourceArn:    f.ARN,
+					Managed:        tr != nil,
+					Terraform:      tr,
+				})
+			}
+		}
+	}
+	return ownerships, nil
+}
+
+func listIacEc2Instances(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	instances, err := listEc2Instances(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(instances))
+	for i, instance := range instances {
+		id := aws.ToString(instance.InstanceId)
+		fetched[i] = iacFetchedResource{ID: id, ARN: client.GenerateResourceARN("ec2", "instance", id, c.Region, c.AccountID)}
+	}
+	return fetched, nil
+}
+
+func listIacEc2EbsVolumes(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	volumes, err := listEc2EbsVolumes(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(volumes))
+	for i, v := range volumes {
+		id := aws.ToString(v.VolumeId)
+		fetched[i] = iacFetchedResource{ID: id, ARN: client.GenerateResourceARN("ec2", "volume", id, c.Region, c.AccountID)}
+	}
+	return fetched, nil
+}
+
+func listIacEc2SecurityGroups(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	groups, err := listEc2SecurityGroups(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(groups))
+	for i, sg := range groups {
+		id := aws.ToString(sg.GroupId)
+		fetched[i] = iacFetchedResource{ID: id, ARN: client.GenerateResourceARN("ec2", "security-group", id, c.Region, c.AccountID)}
+	}
+	return fetched, nil
+}
+
+func listIacEc2Vpcs(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	vpcs, err := listEc2Vpcs(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(vpcs))
+	for i, v := range vpcs {
+		id := aws.ToString(v.VpcId)
+		fetched[i] = iacFetchedResource{ID: id, ARN: client.GenerateResourceARN("ec2", "vpc", id, c.Region, c.AccountID)}
+	}
+	return fetched, nil
+}
+
+func listIacEc2Subnets(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	subnets, err := listEc2Subnets(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(subnets))
+	for i, s := range subnets {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(s.SubnetId), ARN: aws.ToString(s.SubnetArn)}
+	}
+	return fetched, nil
+}
+
+func listIacLambdaFunctions(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	functions, err := listLambdaFunctions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(functions))
+	for i, f := range functions {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(f.FunctionName), ARN: aws.ToString(f.FunctionArn)}
+	}
+	return fetched, nil
+}
+
+func listIacKmsKeys(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	keys, err := listKmsKeys(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(keys))
+	for i, k := range keys {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(k.KeyId), ARN: aws.ToString(k.KeyArn)}
+	}
+	return fetched, nil
+}
+
+func listIacS3Buckets(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	buckets, err := listS3Buckets(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(buckets))
+	for i, b := range buckets {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(b.Name), ARN: fmt.Sprintf("arn:%s:s3:::%s", c.Partition(), aws.ToString(b.Name))}
+	}
+	return fetched, nil
+}
+
+func listIacIamRoles(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	roles, err := listIamRoles(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(roles))
+	for i, r := range roles {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(r.RoleName), ARN: aws.ToString(r.Arn)}
+	}
+	return fetched, nil
+}
+
+func listIacIamUsers(ctx context.Context, c *client.Client) ([]iacFetchedResource, error) {
+	users, err := listIamUsers(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make([]iacFetchedResource, len(users))
+	for i, u := range users {
+		fetched[i] = iacFetchedResource{ID: aws.ToString(u.UserName), ARN: aws.ToString(u.Arn)}
+	}
+	return fetched, nil
+}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3Types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+)
+
+const testIacTerraformState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "web",
+      "instances": [
+        {"attributes": {"id": "i-1", "arn": "arn:aws:ec2:us-east-1:testAccount:instance/i-1"}}
+      ]
+    },
+    {
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "instances": [
+        {"attributes": {"id": "logs", "arn": "arn:aws:s3:::logs"}}
+      ]
+    }
+  ]
+}`
+
+func TestResolveIacOwnerships(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ec2Client := mocks.NewMockEc2Client(ctrl)
+	// the instances are listed once for both the instances table and the ownership
+	ec2Client.EXPECT().DescribeInstances(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(
+		&ec2.DescribeInstancesOutput{Reservations: []ec2Types.Reservation{{
+			Instances: []ec2Types.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}},
+		}}}, nil)
+	ec2Client.EXPECT().DescribeVolumes(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeVolumesOutput{}, nil)
+	ec2Client.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+	ec2Client.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeVpcsOutput{}, nil)
+	ec2Client.EXPECT().DescribeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeSubnetsOutput{}, nil)
+	lambdaClient := mocks.NewMockLambdaClient(ctrl)
+	lambdaClient.EXPECT().ListFunctions(gomock.Any(), gomock.Any(), gomock.Any()).Return(&lambda.ListFunctionsOutput{}, nil)
+	kmsClient := mocks.NewMockKmsClient(ctrl)
+	kmsClient.EXPECT().ListKeys(gomock.Any(), gomock.Any(), gomock.Any()).Return(&kms.ListKeysOutput{}, nil)
+	s3Client := mocks.NewMockS3Client(ctrl)
+	s3Client.EXPECT().ListBuckets(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&s3.ListBucketsOutput{Buckets: []s3Types.Bucket{{Name: aws.String("logs")}}}, nil)
+	iamClient := mocks.NewMockIamClient(ctrl)
+	iamClient.EXPECT().ListRoles(gomock.Any(), gomock.Any(), gomock.Any()).Return(&iam.ListRolesOutput{}, nil)
+	iamClient.EXPECT().ListUsers(gomock.Any(), gomock.Any(), gomock.Any()).Return(&iam.ListUsersOutput{}, nil)
+
+	c := client.NewAwsClient(hclog.NewNullLogger(), []string{"us-east-1"})
+	c.ServicesManager.InitServicesForAccountAndRegion("testAccount", "us-east-1", client.Services{
+		EC2:    ec2Client,
+		IAM:    iamClient,
+		KMS:    kmsClient,
+		Lambda: lambdaClient,
+		S3:     s3Client,
+	})
+	ac := client.AccountMultiplex(&c)[0].(*client.Client)
+
+	res := make(chan interface{}, 1)
+	if err := fetchEc2Instances(context.Background(), ac.WithRegion("us-east-1"), nil, res); err != nil {
+		t.Fatal(err)
+	}
+	if instances := (<-res).([]ec2Types.Instance); len(instances) != 2 {
+		t.Fatalf("expected 2 fetched instances, got %d", len(instances))
+	}
+
+	dir, err := ioutil.TempDir("", "tfstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "terraform.tfstate"), []byte(testIacTerraformState), 0600); err != nil {
+		t.Fatal(err)
+	}
+	state, err := client.LoadTerraformState([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ownerships, err := resolveIacOwnerships(context.Background(), ac, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byId := make(map[string]iacOwnership)
+	for _, o := range ownerships {
+		byId[o.ResourceId] = o
+	}
+	if len(byId) != 3 {
+		t.Fatalf("expected ownerships of 2 instances and a bucket, got %+v", ownerships)
+	}
+	if o := byId["i-1"]; !o.Managed || o.Terraform.Address != "aws_instance.web" || o.ResourceRegion != "us-east-1" {
+		t.Errorf("expected i-1 to be managed by aws_instance.web, got %+v", o)
+	}
+	if o := byId["i-2"]; o.Managed || o.Terraform != nil {
+		t.Errorf("expected i-2 to be unmanaged, got %+v", o)
+	}
+	if o := byId["logs"]; !o.Managed || o.ResourceArn != "arn:aws:s3:::logs" || o.ResourceRegion != "" {
+		t.Errorf("expected the logs bucket to be managed, got %+v", o)
+	}
+}
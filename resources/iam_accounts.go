@@ -12,9 +12,9 @@ import (
 
 func Accounts() *schema.Table {
 	return &schema.Table{
-		Name:         "aws_accounts",
+		Name:         "aws_iam_accounts",
 		Resolver:     fetchAccountSummary,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -23,6 +23,11 @@ func Accounts() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "users",
 				Type: schema.TypeInt,
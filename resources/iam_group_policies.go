@@ -19,11 +19,9 @@ import (
 
 func iamGroupPolicies() *schema.Table {
 	return &schema.Table{
-		Name:         "aws_iam_group_policies",
-		Resolver:     fetchIamGroupPolicies,
-		Multiplex:    client.AccountMultiplex,
-		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountFilter,
+		Name:        "aws_iam_group_policies",
+		Resolver:    fetchIamGroupPolicies,
+		IgnoreError: client.IgnoreAccessDeniedServiceDisabled,
 		Columns: []schema.Column{
 			{
 				Name:     "group_id",
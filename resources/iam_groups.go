@@ -14,7 +14,7 @@ func IamGroups() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_groups",
 		Resolver:     fetchIamGroups,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -13,7 +13,7 @@ func IamOpenidConnectIdentityProviders() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_openid_connect_identity_providers",
 		Resolver:     fetchIamOpenidConnectIdentityProviders,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -22,6 +22,11 @@ func IamOpenidConnectIdentityProviders() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name:     "client_id_list",
 				Type:     schema.TypeStringArray,
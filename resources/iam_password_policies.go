@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 
-	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/smithy-go"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -15,9 +14,9 @@ func IamPasswordPolicies() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_password_policies",
 		Resolver:     fetchIamPasswordPolicies,
-		Multiplex:    client.AccountRegionMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -78,9 +77,7 @@ func IamPasswordPolicies() *schema.Table {
 // ====================================================================================================================
 func fetchIamPasswordPolicies(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	var passwordPolicies []*types.PasswordPolicy
-	var config iam.GetAccountPasswordPolicyInput
-	svc := meta.(*client.Client).Services().IAM
-	response, err := svc.GetAccountPasswordPolicy(ctx, &config)
+	response, err := getIamPasswordPolicy(ctx, meta.(*client.Client))
 	if err != nil {
 		var ae smithy.APIError
 		if errors.As(err, &ae) && ae.ErrorCode() == "NoSuchEntity" {
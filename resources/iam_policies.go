@@ -15,7 +15,7 @@ func IamPolicies() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_policies",
 		Resolver:     fetchIamPolicies,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -19,11 +19,9 @@ import (
 
 func iamRolePolicies() *schema.Table {
 	return &schema.Table{
-		Name:         "aws_iam_role_policies",
-		Resolver:     fetchIamRolePolicies,
-		Multiplex:    client.AccountMultiplex,
-		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountFilter,
+		Name:        "aws_iam_role_policies",
+		Resolver:    fetchIamRolePolicies,
+		IgnoreError: client.IgnoreAccessDeniedServiceDisabled,
 		Columns: []schema.Column{
 			{
 				Name:     "role_id",
@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/url"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -15,7 +14,7 @@ func IamRoles() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_roles",
 		Resolver:     fetchIamRoles,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -24,6 +23,11 @@ func IamRoles() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name:     "policies",
 				Type:     schema.TypeJSON,
@@ -98,20 +102,8 @@ func IamRoles() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchIamRoles(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var config iam.ListRolesInput
-	svc := meta.(*client.Client).Services().IAM
-	for {
-		response, err := svc.ListRoles(ctx, &config)
-		if err != nil {
-			return err
-		}
-		res <- response.Roles
-		if aws.ToString(response.Marker) == "" {
-			break
-		}
-		config.Marker = response.Marker
-	}
-	return nil
+	c := meta.(*client.Client)
+	return c.SendPages(ctx, iamRolesListing(c), res)
 }
 func resolveIamRolePolicies(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.Role)
@@ -13,7 +13,7 @@ func IamSamlIdentityProviders() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_saml_identity_providers",
 		Resolver:     fetchIamSamlIdentityProviders,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -22,6 +22,11 @@ func IamSamlIdentityProviders() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "create_date",
 				Type: schema.TypeTimestamp,
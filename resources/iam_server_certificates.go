@@ -13,7 +13,7 @@ func IamServerCertificates() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_server_certificates",
 		Resolver:     fetchIamServerCertificates,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -22,6 +22,11 @@ func IamServerCertificates() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "arn",
 				Type: schema.TypeString,
@@ -17,11 +17,9 @@ import (
 
 func iamUserPolicies() *schema.Table {
 	return &schema.Table{
-		Name:         "aws_iam_user_policies",
-		Resolver:     fetchIamUserPolicies,
-		Multiplex:    client.AccountMultiplex,
-		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountFilter,
+		Name:        "aws_iam_user_policies",
+		Resolver:    fetchIamUserPolicies,
+		IgnoreError: client.IgnoreAccessDeniedServiceDisabled,
 		Columns: []schema.Column{
 			{
 				Name:     "user_id",
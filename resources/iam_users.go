@@ -3,7 +3,6 @@ package resources
 import (
 	"context"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/gocarina/gocsv"
@@ -23,7 +22,7 @@ func IamUsers() *schema.Table {
 	return &schema.Table{
 		Name:                 "aws_iam_users",
 		Resolver:             fetchIamUsers,
-		Multiplex:            client.AccountMultiplex,
+		Multiplex:            client.GlobalMultiplex,
 		IgnoreError:          client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:         client.DeleteAccountFilter,
 		PostResourceResolver: postIamUserResolver,
@@ -33,6 +32,11 @@ func IamUsers() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "password_last_used",
 				Type: schema.TypeTimestamp,
@@ -219,15 +223,13 @@ func IamUsers() *schema.Table {
 }
 
 func fetchIamUsers(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config iam.ListUsersInput
-	svc := meta.(*client.Client).Services().IAM
-	report, err := getCredentialReport(ctx, meta)
+	report, err := listCredentialReport(ctx, meta.(*client.Client))
 	if err != nil {
 		return err
 	}
 	meta.(*client.Client).ReportUsers = nil
 
-	root := report.GetUser(fmt.Sprintf("arn:aws:iam::%s:root", meta.(*client.Client).AccountID))
+	root := report.GetUser(accountARN(meta.(*client.Client)))
 	if root != nil {
 		res <- wrappedUser{
 			User: types.User{
@@ -239,14 +241,11 @@ func fetchIamUsers(ctx context.Context, meta schema.ClientMeta, _ *schema.Resour
 		}
 	}
 
-	for {
-		output, err := svc.ListUsers(ctx, &config)
-		if err != nil {
-			return err
-		}
-
-		wUsers := make([]wrappedUser, len(output.Users))
-		for i, u := range output.Users {
+	c := meta.(*client.Client)
+	return c.EachPage(ctx, iamUsersListing(c), func(items interface{}) error {
+		users := items.([]types.User)
+		wUsers := make([]wrappedUser, len(users))
+		for i, u := range users {
 			ru := report.GetUser(aws.ToString(u.Arn))
 			if ru == nil {
 				meta.Logger().Warn("failed to find user in credential report", "arn", u.Arn)
@@ -257,14 +256,9 @@ func fetchIamUsers(ctx context.Context, meta schema.ClientMeta, _ *schema.Resour
 				reportUser: ru,
 			}
 		}
-
 		res <- wUsers
-		if aws.ToString(output.Marker) == "" {
-			break
-		}
-		config.Marker = output.Marker
-	}
-	return nil
+		return nil
+	})
 }
 
 func postIamUserResolver(_ context.Context, _ schema.ClientMeta, resource *schema.Resource) error {
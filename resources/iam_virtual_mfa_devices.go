@@ -14,7 +14,7 @@ func IamVirtualMfaDevices() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_iam_virtual_mfa_devices",
 		Resolver:     fetchIamVirtualMfaDevices,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -3,8 +3,6 @@ package resources
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -109,76 +107,58 @@ func KmsKeys() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchKmsKeys(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var input kms.ListKeysInput
 	c := meta.(*client.Client)
-	svc := c.Services().KMS
-	for {
-		response, err := svc.ListKeys(ctx, &input, func(options *kms.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.Keys
-		if aws.ToString(response.NextMarker) == "" {
-			break
-		}
-		input.Marker = response.NextMarker
-	}
-	return nil
+	return c.SendPages(ctx, kmsKeysListing(c), res)
 }
 func resolveKmsKey(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
 	r := resource.Item.(types.KeyListEntry)
 	c := meta.(*client.Client)
-	svc := c.Services().KMS
-	output, err := svc.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: r.KeyId}, func(options *kms.Options) {
-		options.Region = c.Region
-	})
+	metadata, err := describeKmsKey(ctx, c, r.KeyId)
 	if err != nil {
 		return err
 	}
-	if output.KeyMetadata != nil {
-		if err := resource.Set("cloud_hsm_cluster_id", output.KeyMetadata.CloudHsmClusterId); err != nil {
+	if metadata != nil {
+		if err := resource.Set("cloud_hsm_cluster_id", metadata.CloudHsmClusterId); err != nil {
 			return err
 		}
-		if err := resource.Set("creation_date", output.KeyMetadata.CreationDate); err != nil {
+		if err := resource.Set("creation_date", metadata.CreationDate); err != nil {
 			return err
 		}
-		if err := resource.Set("custom_key_store_id", output.KeyMetadata.CustomKeyStoreId); err != nil {
+		if err := resource.Set("custom_key_store_id", metadata.CustomKeyStoreId); err != nil {
 			return err
 		}
-		if err := resource.Set("customer_master_key_spec", output.KeyMetadata.CustomerMasterKeySpec); err != nil {
+		if err := resource.Set("customer_master_key_spec", metadata.CustomerMasterKeySpec); err != nil {
 			return err
 		}
-		if err := resource.Set("deletion_date", output.KeyMetadata.DeletionDate); err != nil {
+		if err := resource.Set("deletion_date", metadata.DeletionDate); err != nil {
 			return err
 		}
-		if err := resource.Set("description", output.KeyMetadata.Description); err != nil {
+		if err := resource.Set("description", metadata.Description); err != nil {
 			return err
 		}
-		if err := resource.Set("enabled", output.KeyMetadata.Enabled); err != nil {
+		if err := resource.Set("enabled", metadata.Enabled); err != nil {
 			return err
 		}
-		if err := resource.Set("expiration_model", output.KeyMetadata.ExpirationModel); err != nil {
+		if err := resource.Set("expiration_model", metadata.ExpirationModel); err != nil {
 			return err
 		}
-		if err := resource.Set("manager", output.KeyMetadata.KeyManager); err != nil {
+		if err := resource.Set("manager", metadata.KeyManager); err != nil {
 			return err
 		}
-		if err := resource.Set("key_state", output.KeyMetadata.KeyState); err != nil {
+		if err := resource.Set("key_state", metadata.KeyState); err != nil {
 			return err
 		}
-		if err := resource.Set("key_usage", output.KeyMetadata.KeyUsage); err != nil {
+		if err := resource.Set("key_usage", metadata.KeyUsage); err != nil {
 			return err
 		}
-		if err := resource.Set("origin", output.KeyMetadata.Origin); err != nil {
+		if err := resource.Set("origin", metadata.Origin); err != nil {
 			return err
 		}
-		if err := resource.Set("valid_to", output.KeyMetadata.ValidTo); err != nil {
+		if err := resource.Set("valid_to", metadata.ValidTo); err != nil {
 			return err
 		}
 		var encryptionAlgorithms []string
-		for _, algorithm := range output.KeyMetadata.EncryptionAlgorithms {
+		for _, algorithm := range metadata.EncryptionAlgorithms {
 			encryptionAlgorithms = append(encryptionAlgorithms, string(algorithm))
 		}
 		if err := resource.Set("encryption_algorithms", encryptionAlgorithms); err != nil {
@@ -186,7 +166,7 @@ func resolveKmsKey(ctx context.Context, meta schema.ClientMeta, resource *schema
 		}
 
 		var signingAlgorithms []string
-		for _, algorithm := range output.KeyMetadata.SigningAlgorithms {
+		for _, algorithm := range metadata.SigningAlgorithms {
 			signingAlgorithms = append(signingAlgorithms, string(algorithm))
 		}
 		if err := resource.Set("signing_algorithms", signingAlgorithms); err != nil {
@@ -194,14 +174,12 @@ func resolveKmsKey(ctx context.Context, meta schema.ClientMeta, resource *schema
 		}
 	}
 
-	if string(output.KeyMetadata.Origin) != "EXTERNAL" {
-		output, err := svc.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: r.KeyId}, func(options *kms.Options) {
-			options.Region = c.Region
-		})
+	if metadata != nil && string(metadata.Origin) != "EXTERNAL" {
+		rotationEnabled, err := getKmsKeyRotationStatus(ctx, c, r.KeyId)
 		if err != nil {
 			return err
 		}
-		if err := resource.Set("rotation_enabled", output.KeyRotationEnabled); err != nil {
+		if err := resource.Set("rotation_enabled", rotationEnabled); err != nil {
 			return err
 		}
 	}
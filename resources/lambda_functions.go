@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/aws/smithy-go"
@@ -124,7 +123,7 @@ func LambdaFunctions() *schema.Table {
 			{
 				Name:     "environment_variables",
 				Type:     schema.TypeJSON,
-				Resolver: schema.PathResolver("Configuration.Environment.Variables"),
+				Resolver: resolveLambdaFunctionEnvironmentVariables,
 			},
 			{
 				Name:     "function_arn",
@@ -440,7 +439,7 @@ func LambdaFunctions() *schema.Table {
 					{
 						Name:     "environment_variables",
 						Type:     schema.TypeJSON,
-						Resolver: schema.PathResolver("Environment.Variables"),
+						Resolver: resolveLambdaFunctionVersionEnvironmentVariables,
 					},
 					{
 						Name: "function_arn",
@@ -794,18 +793,10 @@ func LambdaFunctions() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchLambdaFunctions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
-	var input lambda.ListFunctionsInput
 	c := meta.(*client.Client)
 	svc := c.Services().Lambda
-	for {
-		response, err := svc.ListFunctions(ctx, &input, func(options *lambda.Options) {
-			options.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-
-		for _, f := range response.Functions {
+	return c.EachPage(ctx, lambdaFunctionsListing(c), func(items interface{}) error {
+		for _, f := range items.([]types.FunctionConfiguration) {
 			getFunctionInput := lambda.GetFunctionInput{
 				FunctionName: f.FunctionName,
 			}
@@ -817,13 +808,8 @@ func fetchLambdaFunctions(ctx context.Context, meta schema.ClientMeta, parent *s
 			}
 			res <- funcResponse
 		}
-
-		if aws.ToString(response.NextMarker) == "" {
-			break
-		}
-		input.Marker = response.NextMarker
-	}
-	return nil
+		return nil
+	})
 }
 func resolvePolicyCodeSigningConfig(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
 	r, ok := resource.Item.(*lambda.GetFunctionOutput)
@@ -913,6 +899,16 @@ func resolvePolicyCodeSigningConfig(ctx context.Context, meta schema.ClientMeta,
 
 	return nil
 }
+func resolveLambdaFunctionEnvironmentVariables(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r, ok := resource.Item.(*lambda.GetFunctionOutput)
+	if !ok {
+		return fmt.Errorf("wrong type assertion: got %T instead of *GetFunctionOutput", r)
+	}
+	if r.Configuration == nil || r.Configuration.Environment == nil {
+		return nil
+	}
+	return resource.Set(c.Name, redactSecretVariables(meta, r.Configuration.Environment.Variables))
+}
 func fetchLambdaFunctionFileSystemConfigs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	r, ok := parent.Item.(*lambda.GetFunctionOutput)
 	if !ok {
@@ -1000,6 +996,16 @@ func fetchLambdaFunctionVersions(ctx context.Context, meta schema.ClientMeta, pa
 	}
 	return nil
 }
+func resolveLambdaFunctionVersionEnvironmentVariables(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r, ok := resource.Item.(types.FunctionConfiguration)
+	if !ok {
+		return fmt.Errorf("wrong type assertion: got %T instead of FunctionConfiguration", r)
+	}
+	if r.Environment == nil {
+		return nil
+	}
+	return resource.Set(c.Name, redactSecretVariables(meta, r.Environment.Variables))
+}
 func fetchLambdaFunctionVersionFileSystemConfigs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
 	r, ok := parent.Item.(types.FunctionConfiguration)
 	if !ok {
@@ -13,9 +13,9 @@ func OrganizationsAccounts() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_organizations_accounts",
 		Resolver:     fetchOrganizationsAccounts,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter: client.DeleteAccountRegionFilter,
+		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
 			{
 				Name:     "account_id",
@@ -6,13 +6,24 @@ import (
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 )
 
+// targetedResources are the resources refreshed by a targeted refresh, the other tables keep their rows.
+var targetedResources = map[string]bool{
+	"ec2.instances":       true,
+	"ec2.ebs_volumes":     true,
+	"ec2.security_groups": true,
+	"s3.buckets":          true,
+}
+
 func Provider() *provider.Provider {
-	return &provider.Provider{
+	p := &provider.Provider{
 		Name:      "aws",
 		Configure: client.Configure,
 		ResourceMap: map[string]*schema.Table{
 			"accessanalyzer.analyzers":              AccessAnalyzerAnalyzer(),
+			"autoscaling.groups":                    AutoscalingGroups(),
 			"autoscaling.launch_configurations":     AutoscalingLaunchConfigurations(),
+			"autoscaling.scaling_policies":          AutoscalingScalingPolicies(),
+			"autoscaling.scheduled_actions":         AutoscalingScheduledActions(),
 			"apigateway.rest_apis":                  ApigatewayRestApis(),
 			"apigateway.domain_names":               ApigatewayDomainNames(),
 			"apigateway.client_certificates":        ApigatewayClientCertificates(),
@@ -27,6 +38,7 @@ func Provider() *provider.Provider {
 			"cloudtrail.trails":                     CloudtrailTrails(),
 			"cloudwatch.alarms":                     CloudwatchAlarms(),
 			"cloudwatchlogs.filters":                CloudwatchlogsFilters(),
+			"compliance.cis_v1_4":                   ComplianceResults(),
 			"config.configuration_recorders":        ConfigConfigurationRecorders(),
 			"config.conformance_packs":              ConfigConformancePack(),
 			"s3.buckets":                            S3Buckets(),
@@ -46,10 +58,32 @@ func Provider() *provider.Provider {
 			"ec2.transit_gateways":                  Ec2TransitGateways(),
 			"ec2.vpc_peering_connections":           Ec2VpcPeeringConnections(),
 			"ec2.vpc_endpoints":                     Ec2VpcEndpoints(),
+			"ec2.vpc_endpoint_services":             Ec2VpcEndpointServices(),
 			"ec2.vpcs":                              Ec2Vpcs(),
+			"ec2.vpc_cidr_overlaps":                 Ec2VpcCidrOverlaps(),
+			"ec2.dhcp_options":                      Ec2DhcpOptions(),
+			"ec2.egress_only_internet_gateways":     Ec2EgressOnlyInternetGateways(),
+			"ec2.managed_prefix_lists":              Ec2ManagedPrefixLists(),
 			"ec2.instances":                         Ec2Instances(),
+			"ec2.instance_statuses":                 Ec2InstanceStatuses(),
+			"ec2.instance_types":                    Ec2InstanceTypes(),
+			"ec2.hosts":                             Ec2Hosts(),
+			"ec2.placement_groups":                  Ec2PlacementGroups(),
+			"ec2.capacity_reservations":             Ec2CapacityReservations(),
+			"ec2.reserved_instances":                Ec2ReservedInstances(),
+			"ec2.reserved_instance_coverage":        Ec2ReservedInstanceCoverage(),
+			"ec2.spot_fleet_requests":               Ec2SpotFleetRequests(),
+			"ec2.spot_instance_requests":            Ec2SpotInstanceRequests(),
 			"ec2.security_groups":                   Ec2SecurityGroups(),
+			"ec2.security_group_usages":             Ec2SecurityGroupUsages(),
 			"ec2.ebs_volumes":                       Ec2EbsVolumes(),
+			"ec2.ebs_snapshots":                     Ec2EbsSnapshots(),
+			"ec2.eips":                              Ec2Eips(),
+			"ec2.key_pairs":                         Ec2KeyPairs(),
+			"ec2.network_interfaces":                Ec2NetworkInterfaces(),
+			"ec2.launch_templates":                  Ec2LaunchTemplates(),
+			"ec2.vpn_connections":                   Ec2VpnConnections(),
+			"ec2.vpn_gateways":                      Ec2VpnGateways(),
 			"ecr.repositories":                      EcrRepositories(),
 			"efs.filesystems":                       EfsFilesystems(),
 			"eks.clusters":                          EksClusters(),
@@ -60,6 +94,7 @@ func Provider() *provider.Provider {
 			"elbv2.load_balancers":                  Elbv2LoadBalancers(),
 			"emr.clusters":                          EmrClusters(),
 			"fsx.backups":                           FsxBackups(),
+			"iac.ownership":                         IacOwnership(),
 			"iam.accounts":                          Accounts(),
 			"iam.groups":                            IamGroups(),
 			"iam.policies":                          IamPolicies(),
@@ -72,12 +107,14 @@ func Provider() *provider.Provider {
 			"iam.server_certificates":               IamServerCertificates(),
 			"kms.keys":                              KmsKeys(),
 			"organizations.accounts":                OrganizationsAccounts(),
+			"secrets.findings":                      SecretFindings(),
 			"sns.topics":                            SnsTopics(),
 			"sns.subscriptions":                     SnsSubscriptions(),
 			"rds.certificates":                      RdsCertificates(),
 			"rds.clusters":                          RdsClusters(),
 			"rds.db_subnet_groups":                  RdsSubnetGroups(),
 			"rds.instances":                         RdsInstances(),
+			"redacted.columns":                      RedactedColumns(),
 			"redshift.clusters":                     RedshiftClusters(),
 			"redshift.subnet_groups":                RedshiftSubnetGroups(),
 			"route53.reusable_delegation_sets":      Route53ReusableDelegationSets(),
@@ -91,4 +128,11 @@ func Provider() *provider.Provider {
 			return &client.Config{}
 		},
 	}
+	for name, t := range p.ResourceMap {
+		if !targetedResources[name] {
+			client.SkipWhenTargeted(t)
+		}
+		client.WithMemoScope(client.WithColumnPolicies(client.WithResourceCaps(t)))
+	}
+	return p
 }
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -416,23 +414,8 @@ func RdsClusters() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchRdsClusters(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config rds.DescribeDBClustersInput
 	c := meta.(*client.Client)
-	svc := c.Services().RDS
-	for {
-		response, err := svc.DescribeDBClusters(ctx, &config, func(o *rds.Options) {
-			o.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.DBClusters
-		if aws.ToString(response.Marker) == "" {
-			break
-		}
-		config.Marker = response.Marker
-	}
-	return nil
+	return c.SendPages(ctx, rdsClustersListing(c), res)
 }
 func resolveRdsClusterTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.DBCluster)
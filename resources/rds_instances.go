@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -604,23 +602,8 @@ func RdsInstances() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchRdsInstances(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config rds.DescribeDBInstancesInput
 	c := meta.(*client.Client)
-	svc := c.Services().RDS
-	for {
-		response, err := svc.DescribeDBInstances(ctx, &config, func(o *rds.Options) {
-			o.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.DBInstances
-		if aws.ToString(response.Marker) == "" {
-			break
-		}
-		config.Marker = response.Marker
-	}
-	return nil
+	return c.SendPages(ctx, rdsInstancesListing(c), res)
 }
 func resolveRdsInstanceTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.DBInstance)
@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func RedactedColumns() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_redacted_columns",
+		Description:  "Columns whose stored values are rewritten by the redact and max_column_bytes configuration",
+		Resolver:     fetchRedactedColumns,
+		Multiplex:    client.AccountMultiplex,
+		DeleteFilter: client.DeleteAccountFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:        "table_name",
+				Type:        schema.TypeString,
+				Description: "The table of the column, i.e aws_iam_server_certificates",
+				Resolver:    schema.PathResolver("Table"),
+			},
+			{
+				Name:        "column_name",
+				Type:        schema.TypeString,
+				Description: "The name of the column",
+				Resolver:    schema.PathResolver("Column"),
+			},
+			{
+				Name:        "action",
+				Type:        schema.TypeString,
+				Description: "The redact action applied to every value of the column, one of hash, truncate or drop. Empty for columns that are only truncated when longer than max_column_bytes",
+			},
+			{
+				Name:        "max_column_bytes",
+				Type:        schema.TypeBigInt,
+				Description: "The number of bytes values longer than are truncated, json values keep only their size and hash",
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchRedactedColumns(_ context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	res <- c.ColumnPolicies().List()
+	return nil
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/logging"
+	"github.com/cloudquery/cq-provider-sdk/provider/providertest"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestRedactedColumns(t *testing.T) {
+	policies, err := client.NewColumnPolicies(
+		map[string]string{"aws_iam_policy_versions.document": client.RedactHash},
+		map[string]int{"aws_iam_policy_versions.document": 65536},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	providertest.TestResource(t, Provider, providertest.ResourceTestData{
+		Table: RedactedColumns(),
+		Config: client.Config{
+			Regions:        []string{"us-east-1"},
+			Accounts:       []client.Account{{ID: "testAccount", RoleARN: ""}},
+			Redact:         map[string]string{"aws_iam_policy_versions.document": client.RedactHash},
+			MaxColumnBytes: map[string]int{"aws_iam_policy_versions.document": 65536},
+			MaxRetries:     3,
+			MaxBackoff:     60,
+		},
+		Configure: func(logger hclog.Logger, i interface{}) (schema.ClientMeta, error) {
+			c := client.NewAwsClient(logging.New(&hclog.LoggerOptions{
+				Level: hclog.Warn,
+			}), []string{"us-east-1"})
+			c.ServicesManager.InitServicesForAccountAndRegion("testAccount", "us-east-1", client.Services{})
+			c.SetColumnPolicies(policies)
+			return &c, nil
+		},
+	})
+}
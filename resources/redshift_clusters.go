@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -558,23 +556,8 @@ func RedshiftClusters() *schema.Table {
 //                                               Table Resolver Functions
 // ====================================================================================================================
 func fetchRedshiftClusters(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	var config redshift.DescribeClustersInput
 	c := meta.(*client.Client)
-	svc := c.Services().Redshift
-	for {
-		response, err := svc.DescribeClusters(ctx, &config, func(o *redshift.Options) {
-			o.Region = c.Region
-		})
-		if err != nil {
-			return err
-		}
-		res <- response.Clusters
-		if aws.ToString(response.Marker) == "" {
-			break
-		}
-		config.Marker = response.Marker
-	}
-	return nil
+	return c.SendPages(ctx, redshiftClustersListing(c), res)
 }
 func resolveRedshiftClusterTags(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, _ schema.Column) error {
 	r := resource.Item.(types.Cluster)
@@ -13,7 +13,7 @@ func Route53ReusableDelegationSets() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_route53_reusable_delegation_sets",
 		Resolver:     fetchRoute53DelegationSets,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -22,6 +22,11 @@ func Route53ReusableDelegationSets() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "name_servers",
 				Type: schema.TypeStringArray,
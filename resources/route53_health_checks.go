@@ -15,7 +15,7 @@ func Route53HealthChecks() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_route53_health_checks",
 		Resolver:     fetchRoute53HealthChecks,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -24,6 +24,11 @@ func Route53HealthChecks() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name:     "cloud_watch_alarm_configuration_dimensions",
 				Type:     schema.TypeJSON,
@@ -16,7 +16,7 @@ func Route53HostedZones() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_route53_hosted_zones",
 		Resolver:     fetchRoute53HostedZones,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -25,6 +25,11 @@ func Route53HostedZones() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name: "tags",
 				Type: schema.TypeJSON,
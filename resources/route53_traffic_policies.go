@@ -16,7 +16,7 @@ func Route53TrafficPolicies() *schema.Table {
 	return &schema.Table{
 		Name:         "aws_route53_traffic_policies",
 		Resolver:     fetchRoute53TrafficPolicies,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.GlobalMultiplex,
 		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter: client.DeleteAccountFilter,
 		Columns: []schema.Column{
@@ -25,6 +25,11 @@ func Route53TrafficPolicies() *schema.Table {
 				Type:     schema.TypeString,
 				Resolver: client.ResolveAWSAccount,
 			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
 			{
 				Name:     "resource_id",
 				Type:     schema.TypeString,
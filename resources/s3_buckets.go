@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
@@ -18,7 +19,7 @@ func S3Buckets() *schema.Table {
 		Resolver:             fetchS3Buckets,
 		Multiplex:            client.AccountMultiplex,
 		IgnoreError:          client.IgnoreAccessDeniedServiceDisabled,
-		DeleteFilter:         client.DeleteAccountFilter,
+		DeleteFilter:         client.DeleteTargetFilter(client.DeleteAccountFilter, "s3", "", "name"),
 		PostResourceResolver: resolveS3BucketsAttributes,
 		Columns: []schema.Column{
 			{
@@ -362,14 +363,24 @@ type WrappedBucket struct {
 }
 
 func fetchS3Buckets(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan interface{}) error {
-	svc := meta.(*client.Client).Services().S3
-	response, err := svc.ListBuckets(ctx, nil)
+	c := meta.(*client.Client)
+	buckets, err := listS3Buckets(ctx, c)
 	if err != nil {
 		return err
 	}
-	wb := make([]*WrappedBucket, len(response.Buckets))
-	for i, b := range response.Buckets {
-		wb[i] = &WrappedBucket{b, nil, nil}
+	var targeted map[string]bool
+	if c.Targeted() {
+		targeted = make(map[string]bool)
+		for _, name := range c.TargetedResourceIDs("s3", "") {
+			targeted[name] = true
+		}
+	}
+	wb := make([]*WrappedBucket, 0, len(buckets))
+	for _, b := range buckets {
+		if targeted != nil && !targeted[aws.ToString(b.Name)] {
+			continue
+		}
+		wb = append(wb, &WrappedBucket{b, nil, nil})
 	}
 
 	res <- wb
@@ -380,8 +391,8 @@ func resolveS3BucketsAttributes(ctx context.Context, meta schema.ClientMeta, res
 	log := meta.Logger()
 	r := resource.Item.(*WrappedBucket)
 	log.Info("bucket name", r.Name)
-	mgr := meta.(*client.Client).Services().S3Manager
-	output, err := mgr.GetBucketRegion(ctx, *r.Name)
+	c := meta.(*client.Client)
+	bucketRegion, err := getS3BucketRegion(ctx, c, *r.Name)
 	if err != nil {
 		if errors.As(err, &ae) && ae.ErrorCode() == "NoSuchBucket" {
 			// https://aws.amazon.com/premiumsupport/knowledge-center/s3-listing-deleted-bucket/
@@ -391,12 +402,7 @@ func resolveS3BucketsAttributes(ctx context.Context, meta schema.ClientMeta, res
 		}
 		return err
 	}
-	svc := meta.(*client.Client).Services().S3
-	bucketRegion := "us-east-1"
-	if output != "" {
-		// This is a weird corner case by AWS API https://github.com/aws/aws-sdk-net/issues/323#issuecomment-196584538
-		bucketRegion = output
-	}
+	svc := c.Services().S3
 	if err := resource.Set("region", bucketRegion); err != nil {
 		return err
 	}
@@ -442,25 +448,23 @@ func resolveS3BucketsAttributes(ctx context.Context, meta schema.ClientMeta, res
 		return err
 	}
 
-	publicAccessOutput, err := svc.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: r.Name}, func(options *s3.Options) {
-		options.Region = bucketRegion
-	})
+	publicAccessBlock, err := getS3PublicAccessBlock(ctx, c, *r.Name, bucketRegion)
 	if err != nil {
 		// If we received any error other than ReplicationConfigurationNotFoundError, we return and error
 		if !errors.As(err, &ae) && ae.ErrorCode() != "NoSuchPublicAccessBlockConfiguration" {
 			return err
 		}
 	} else {
-		if err := resource.Set("block_public_acls", publicAccessOutput.PublicAccessBlockConfiguration.BlockPublicAcls); err != nil {
+		if err := resource.Set("block_public_acls", publicAccessBlock.BlockPublicAcls); err != nil {
 			return err
 		}
-		if err := resource.Set("block_public_policy", publicAccessOutput.PublicAccessBlockConfiguration.BlockPublicPolicy); err != nil {
+		if err := resource.Set("block_public_policy", publicAccessBlock.BlockPublicPolicy); err != nil {
 			return err
 		}
-		if err := resource.Set("ignore_public_acls", publicAccessOutput.PublicAccessBlockConfiguration.IgnorePublicAcls); err != nil {
+		if err := resource.Set("ignore_public_acls", publicAccessBlock.IgnorePublicAcls); err != nil {
 			return err
 		}
-		if err := resource.Set("restrict_public_buckets", publicAccessOutput.PublicAccessBlockConfiguration.RestrictPublicBuckets); err != nil {
+		if err := resource.Set("restrict_public_buckets", publicAccessBlock.RestrictPublicBuckets); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,286 @@
+package resources
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	ecstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// elasticbeanstalkEnvironmentNamespace holds the environment properties of beanstalk environments
+const elasticbeanstalkEnvironmentNamespace = "aws:elasticbeanstalk:application:environment"
+
+type secretFinding struct {
+	ResourceType string
+	ResourceArn  string
+	Field        string
+	Rule         string
+	Match        string
+	Fingerprint  string
+}
+
+// secretSource scans a single kind of resource holding plain-text configuration. Sources read the listings shared
+// with the tables fetching the resources, so the scan covers the fetched configuration.
+type secretSource struct {
+	ResourceType string
+	Scan         func(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error)
+}
+
+var secretSources = []secretSource{
+	{ResourceType: "aws_autoscaling_launch_configurations", Scan: scanAutoscalingLaunchConfigurations},
+	{ResourceType: "aws_ec2_launch_templates", Scan: scanEc2LaunchTemplateVersions},
+	{ResourceType: "aws_lambda_functions", Scan: scanLambdaFunctions},
+	{ResourceType: "aws_elasticbeanstalk_environments", Scan: scanElasticbeanstalkEnvironments},
+	{ResourceType: "aws_ecs_task_definitions", Scan: scanEcsTaskDefinitions},
+}
+
+func SecretFindings() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_secret_findings",
+		Description:  "Secrets found in the user data of launch configurations and launch template versions, the environment variables of lambda functions and elastic beanstalk environments and the container environment of the latest active revision of ecs task definitions",
+		Resolver:     fetchSecretFindings,
+		Multiplex:    client.AccountRegionMultiplex,
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Columns: []schema.Column{
+			{
+				Name:     "account_id",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSAccount,
+			},
+			{
+				Name:     "region",
+				Type:     schema.TypeString,
+				Resolver: client.ResolveAWSRegion,
+			},
+			{
+				Name: "resource_type",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "resource_arn",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "field",
+				Type: schema.TypeString,
+			},
+			{
+				Name: "rule",
+				Type: schema.TypeString,
+			},
+			{
+				Name:        "match",
+				Type:        schema.TypeString,
+				Description: "The text matched by the rule with the secret redacted, i.e DB_PASSWORD=[REDACTED]",
+			},
+			{
+				Name:        "fingerprint",
+				Type:        schema.TypeString,
+				Description: "The rule and a sha256 prefix of the secret, i.e password:sha256:9f86d081884c7d65, identifying the secret without revealing it",
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchSecretFindings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan interface{}) error {
+	c := meta.(*client.Client)
+	scanner := c.SecretScanner()
+	for _, source := range secretSources {
+		findings, err := source.Scan(ctx, c, scanner)
+		if err != nil {
+			// a disabled or denied service shouldn't hide the findings of the other sources
+			if client.IgnoreAccessDeniedServiceDisabled(err) {
+				meta.Logger().Warn("skipping secret scan", "resource_type", source.ResourceType, "error", err)
+				continue
+			}
+			return err
+		}
+		for i := range findings {
+			findings[i].ResourceType = source.ResourceType
+		}
+		res <- findings
+	}
+	return nil
+}
+
+func scanSecretValue(scanner *client.SecretScanner, arn, field, value string) []secretFinding {
+	var findings []secretFinding
+	for _, m := range scanner.Scan(value) {
+		findings = append(findings, secretFinding{ResourceArn: arn, Field: field, Rule: m.Rule, Match: m.Match, Fingerprint: m.Fingerprint})
+	}
+	return findings
+}
+
+// scanSecretVariables scans environment variables as KEY=value pairs, so rules keyed on the variable name match
+func scanSecretVariables(scanner *client.SecretScanner, arn, field string, variables map[string]string) []secretFinding {
+	var findings []secretFinding
+	for k, v := range variables {
+		findings = append(findings, scanSecretValue(scanner, arn, fmt.Sprintf("%s.%s", field, k), k+"="+v)...)
+	}
+	return findings
+}
+
+// redactSecretVariables returns the environment variables to store, redacting their secrets when redact_secrets is set
+func redactSecretVariables(meta schema.ClientMeta, variables map[string]string) map[string]string {
+	c := meta.(*client.Client)
+	if !c.RedactSecrets() {
+		return variables
+	}
+	scanner := c.SecretScanner()
+	redacted := make(map[string]string, len(variables))
+	for k, v := range variables {
+		redacted[k] = strings.TrimPrefix(scanner.Redact(k+"="+v), k+"=")
+	}
+	return redacted
+}
+
+// decodeUserData returns the decoded user data, or the raw value if it isn't base64 encoded
+func decodeUserData(userData string) string {
+	decoded, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return userData
+	}
+	return string(decoded)
+}
+
+// userDataText reports whether decoded user data can be stored in a text column. Binary user data, such as gzip
+// compressed cloud-init, isn't valid UTF-8 or holds NUL bytes, which postgres text columns reject.
+func userDataText(userData string) bool {
+	return utf8.ValidString(userData) && !strings.Contains(userData, "\x00")
+}
+
+func scanAutoscalingLaunchConfigurations(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error) {
+	configurations, err := listAutoscalingLaunchConfigurations(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var findings []secretFinding
+	for _, lc := range configurations {
+		findings = append(findings, scanSecretValue(scanner, aws.ToString(lc.LaunchConfigurationARN), "user_data", decodeUserData(aws.ToString(lc.UserData)))...)
+	}
+	return findings, nil
+}
+
+func scanEc2LaunchTemplateVersions(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error) {
+	templates, err := listEc2LaunchTemplates(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var findings []secretFinding
+	for _, t := range templates {
+		versions, err := listEc2LaunchTemplateVersions(ctx, c, t.LaunchTemplateId)
+		if err != nil {
+			return nil, err
+		}
+		arn := client.GenerateResourceARN("ec2", "launch-template", aws.ToString(t.LaunchTemplateId), c.Region, c.AccountID)
+		for _, v := range versions {
+			if v.LaunchTemplateData == nil || v.LaunchTemplateData.UserData == nil {
+				continue
+			}
+			field := fmt.Sprintf("versions.%d.user_data", v.VersionNumber)
+			findings = append(findings, scanSecretValue(scanner, arn, field, decodeUserData(aws.ToString(v.LaunchTemplateData.UserData)))...)
+		}
+	}
+	return findings, nil
+}
+
+func scanLambdaFunctions(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error) {
+	functions, err := listLambdaFunctions(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var findings []secretFinding
+	for _, f := range functions {
+		if f.Environment == nil {
+			continue
+		}
+		findings = append(findings, scanSecretVariables(scanner, aws.ToString(f.FunctionArn), "environment_variables", f.Environment.Variables)...)
+	}
+	return findings, nil
+}
+
+// scanElasticbeanstalkEnvironments scans the environment properties, which aren't fetched by
+// aws_elasticbeanstalk_environments, so the configuration settings of every environment are described by the scan.
+func scanElasticbeanstalkEnvironments(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error) {
+	environments, err := listElasticbeanstalkEnvironments(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var findings []secretFinding
+	svc := c.Services().ElasticBeanstalk
+	for _, env := range environments {
+		settings, err := svc.DescribeConfigurationSettings(ctx, &elasticbeanstalk.DescribeConfigurationSettingsInput{
+			ApplicationName: env.ApplicationName,
+			EnvironmentName: env.EnvironmentName,
+		}, func(options *elasticbeanstalk.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		variables := make(map[string]string)
+		for _, s := range settings.ConfigurationSettings {
+			for _, o := range s.OptionSettings {
+				if aws.ToString(o.Namespace) == elasticbeanstalkEnvironmentNamespace {
+					variables[aws.ToString(o.OptionName)] = aws.ToString(o.Value)
+				}
+			}
+		}
+		findings = append(findings, scanSecretVariables(scanner, aws.ToString(env.EnvironmentArn), "environment_variables", variables)...)
+	}
+	return findings, nil
+}
+
+// scanEcsTaskDefinitions scans the environment of the containers of the latest active revision of every task
+// definition family, the revisions a new task or service is started from. Task definitions have no table, so they are
+// listed and described by the scan.
+func scanEcsTaskDefinitions(ctx context.Context, c *client.Client, scanner *client.SecretScanner) ([]secretFinding, error) {
+	var findings []secretFinding
+	svc := c.Services().ECS
+	optFns := func(options *ecs.Options) {
+		options.Region = c.Region
+	}
+	input := ecs.ListTaskDefinitionFamiliesInput{Status: ecstypes.TaskDefinitionFamilyStatusActive}
+	for {
+		output, err := svc.ListTaskDefinitionFamilies(ctx, &input, optFns)
+		if err != nil {
+			return nil, err
+		}
+		for _, family := range output.Families {
+			// describing a family returns its latest active revision
+			definition, err := svc.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{TaskDefinition: aws.String(family)}, optFns)
+			if err != nil {
+				return nil, err
+			}
+			if definition.TaskDefinition == nil {
+				continue
+			}
+			arn := aws.ToString(definition.TaskDefinition.TaskDefinitionArn)
+			for _, container := range definition.TaskDefinition.ContainerDefinitions {
+				variables := make(map[string]string, len(container.Environment))
+				for _, e := range container.Environment {
+					variables[aws.ToString(e.Name)] = aws.ToString(e.Value)
+				}
+				field := fmt.Sprintf("container_definitions.%s.environment", aws.ToString(container.Name))
+				findings = append(findings, scanSecretVariables(scanner, arn, field, variables)...)
+			}
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return findings, nil
+}
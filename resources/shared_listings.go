@@ -0,0 +1,855 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cloudtrailtypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	configtypes "github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	elasticbeanstalktypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+)
+
+// The listings below are shared through client.SharedList, or client.List for paginated listings, by the tables
+// emitting the resources and the tables analysing them (compliance results, iac ownership, secret findings, security
+// group usages), so an analysis evaluates the data stored by the fetch instead of listing the resources again. The
+// emitting tables send paginated listings with client.SendPages, which stops listing once a capped table is full.
+
+func ec2InstancesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.instances", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeInstancesInput
+		for {
+			output, err := c.Services().EC2.DescribeInstances(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			var instances []ec2types.Instance
+			for _, r := range output.Reservations {
+				instances = append(instances, r.Instances...)
+			}
+			if err := page(instances); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2Instances(ctx context.Context, c *client.Client) ([]ec2types.Instance, error) {
+	v, err := c.List(ctx, ec2InstancesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	instances, _ := v.([]ec2types.Instance)
+	return instances, nil
+}
+
+func ec2EbsVolumesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.ebs_volumes", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeVolumesInput
+		for {
+			output, err := c.Services().EC2.DescribeVolumes(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Volumes); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2EbsVolumes(ctx context.Context, c *client.Client) ([]ec2types.Volume, error) {
+	v, err := c.List(ctx, ec2EbsVolumesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	volumes, _ := v.([]ec2types.Volume)
+	return volumes, nil
+}
+
+func ec2SecurityGroupsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.security_groups", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeSecurityGroupsInput
+		for {
+			output, err := c.Services().EC2.DescribeSecurityGroups(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.SecurityGroups); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2SecurityGroups(ctx context.Context, c *client.Client) ([]ec2types.SecurityGroup, error) {
+	v, err := c.List(ctx, ec2SecurityGroupsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	groups, _ := v.([]ec2types.SecurityGroup)
+	return groups, nil
+}
+
+func ec2VpcsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.vpcs", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeVpcsInput
+		for {
+			output, err := c.Services().EC2.DescribeVpcs(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Vpcs); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2Vpcs(ctx context.Context, c *client.Client) ([]ec2types.Vpc, error) {
+	v, err := c.List(ctx, ec2VpcsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	vpcs, _ := v.([]ec2types.Vpc)
+	return vpcs, nil
+}
+
+func ec2SubnetsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.subnets", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeSubnetsInput
+		for {
+			output, err := c.Services().EC2.DescribeSubnets(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Subnets); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2Subnets(ctx context.Context, c *client.Client) ([]ec2types.Subnet, error) {
+	v, err := c.List(ctx, ec2SubnetsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	subnets, _ := v.([]ec2types.Subnet)
+	return subnets, nil
+}
+
+func ec2FlowLogsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.flow_logs", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeFlowLogsInput
+		for {
+			output, err := c.Services().EC2.DescribeFlowLogs(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.FlowLogs); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2FlowLogs(ctx context.Context, c *client.Client) ([]ec2types.FlowLog, error) {
+	v, err := c.List(ctx, ec2FlowLogsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	flowLogs, _ := v.([]ec2types.FlowLog)
+	return flowLogs, nil
+}
+
+func ec2LaunchTemplatesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.launch_templates", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeLaunchTemplatesInput
+		for {
+			output, err := c.Services().EC2.DescribeLaunchTemplates(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.LaunchTemplates); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2LaunchTemplates(ctx context.Context, c *client.Client) ([]ec2types.LaunchTemplate, error) {
+	v, err := c.List(ctx, ec2LaunchTemplatesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	templates, _ := v.([]ec2types.LaunchTemplate)
+	return templates, nil
+}
+
+func listEc2LaunchTemplateVersions(ctx context.Context, c *client.Client, templateID *string) ([]ec2types.LaunchTemplateVersion, error) {
+	v, err := c.SharedList(ctx, "ec2.launch_template_versions/"+aws.ToString(templateID), func(ctx context.Context) (interface{}, error) {
+		var versions []ec2types.LaunchTemplateVersion
+		input := ec2.DescribeLaunchTemplateVersionsInput{LaunchTemplateId: templateID}
+		for {
+			output, err := c.Services().EC2.DescribeLaunchTemplateVersions(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return nil, err
+			}
+			versions = append(versions, output.LaunchTemplateVersions...)
+			if aws.ToString(output.NextToken) == "" {
+				return versions, nil
+			}
+			input.NextToken = output.NextToken
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]ec2types.LaunchTemplateVersion), nil
+}
+
+func getEc2EbsEncryptionByDefault(ctx context.Context, c *client.Client) (bool, error) {
+	v, err := c.SharedList(ctx, "ec2.ebs_encryption_by_default", func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().EC2.GetEbsEncryptionByDefault(ctx, &ec2.GetEbsEncryptionByDefaultInput{}, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.EbsEncryptionByDefault, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+// ec2NetworkInterfacesListing lists the network interfaces of the region, including the interfaces AWS services manage
+// for resources of the account.
+func ec2NetworkInterfacesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.network_interfaces", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeNetworkInterfacesInput
+		for {
+			output, err := c.Services().EC2.DescribeNetworkInterfaces(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.NetworkInterfaces); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2NetworkInterfaces(ctx context.Context, c *client.Client) ([]ec2types.NetworkInterface, error) {
+	v, err := c.List(ctx, ec2NetworkInterfacesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	interfaces, _ := v.([]ec2types.NetworkInterface)
+	return interfaces, nil
+}
+
+func ec2VpcEndpointsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "ec2.vpc_endpoints", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input ec2.DescribeVpcEndpointsInput
+		for {
+			output, err := c.Services().EC2.DescribeVpcEndpoints(ctx, &input, func(options *ec2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.VpcEndpoints); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEc2VpcEndpoints(ctx context.Context, c *client.Client) ([]ec2types.VpcEndpoint, error) {
+	v, err := c.List(ctx, ec2VpcEndpointsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	endpoints, _ := v.([]ec2types.VpcEndpoint)
+	return endpoints, nil
+}
+
+// listCloudtrailTrails lists the trails returned in the client's region, including the shadow trails of multi region
+// trails created in other regions.
+func listCloudtrailTrails(ctx context.Context, c *client.Client) ([]cloudtrailtypes.Trail, error) {
+	v, err := c.SharedList(ctx, "cloudtrail.trails", func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().Cloudtrail.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{}, func(options *cloudtrail.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.TrailList, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]cloudtrailtypes.Trail), nil
+}
+
+func getCloudtrailTrailStatus(ctx context.Context, c *client.Client, trailARN *string) (*cloudtrail.GetTrailStatusOutput, error) {
+	v, err := c.SharedList(ctx, "cloudtrail.trail_status/"+aws.ToString(trailARN), func(ctx context.Context) (interface{}, error) {
+		return c.Services().Cloudtrail.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{Name: trailARN}, func(options *cloudtrail.Options) {
+			options.Region = c.Region
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*cloudtrail.GetTrailStatusOutput), nil
+}
+
+func listConfigConfigurationRecorders(ctx context.Context, c *client.Client) ([]configtypes.ConfigurationRecorder, error) {
+	v, err := c.SharedList(ctx, "config.configuration_recorders", func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().ConfigService.DescribeConfigurationRecorders(ctx, &configservice.DescribeConfigurationRecordersInput{}, func(options *configservice.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.ConfigurationRecorders, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]configtypes.ConfigurationRecorder), nil
+}
+
+func listConfigConfigurationRecorderStatuses(ctx context.Context, c *client.Client) ([]configtypes.ConfigurationRecorderStatus, error) {
+	v, err := c.SharedList(ctx, "config.configuration_recorder_statuses", func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().ConfigService.DescribeConfigurationRecorderStatus(ctx, &configservice.DescribeConfigurationRecorderStatusInput{}, func(options *configservice.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.ConfigurationRecordersStatus, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]configtypes.ConfigurationRecorderStatus), nil
+}
+
+func kmsKeysListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "kms.keys", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input kms.ListKeysInput
+		for {
+			output, err := c.Services().KMS.ListKeys(ctx, &input, func(options *kms.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Keys); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextMarker) == "" {
+				return nil
+			}
+			input.Marker = output.NextMarker
+		}
+	}}
+}
+
+func listKmsKeys(ctx context.Context, c *client.Client) ([]kmstypes.KeyListEntry, error) {
+	v, err := c.List(ctx, kmsKeysListing(c))
+	if err != nil {
+		return nil, err
+	}
+	keys, _ := v.([]kmstypes.KeyListEntry)
+	return keys, nil
+}
+
+func describeKmsKey(ctx context.Context, c *client.Client, keyID *string) (*kmstypes.KeyMetadata, error) {
+	v, err := c.SharedList(ctx, "kms.key/"+aws.ToString(keyID), func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().KMS.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: keyID}, func(options *kms.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.KeyMetadata, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*kmstypes.KeyMetadata), nil
+}
+
+func getKmsKeyRotationStatus(ctx context.Context, c *client.Client, keyID *string) (bool, error) {
+	v, err := c.SharedList(ctx, "kms.key_rotation_status/"+aws.ToString(keyID), func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().KMS.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: keyID}, func(options *kms.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.KeyRotationEnabled, nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return v.(bool), nil
+}
+
+func autoscalingLaunchConfigurationsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "autoscaling.launch_configurations", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input autoscaling.DescribeLaunchConfigurationsInput
+		for {
+			output, err := c.Services().Autoscaling.DescribeLaunchConfigurations(ctx, &input, func(options *autoscaling.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.LaunchConfigurations); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listAutoscalingLaunchConfigurations(ctx context.Context, c *client.Client) ([]autoscalingtypes.LaunchConfiguration, error) {
+	v, err := c.List(ctx, autoscalingLaunchConfigurationsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	configurations, _ := v.([]autoscalingtypes.LaunchConfiguration)
+	return configurations, nil
+}
+
+func elasticbeanstalkEnvironmentsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "elasticbeanstalk.environments", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input elasticbeanstalk.DescribeEnvironmentsInput
+		for {
+			output, err := c.Services().ElasticBeanstalk.DescribeEnvironments(ctx, &input, func(options *elasticbeanstalk.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Environments); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextToken) == "" {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listElasticbeanstalkEnvironments(ctx context.Context, c *client.Client) ([]elasticbeanstalktypes.EnvironmentDescription, error) {
+	v, err := c.List(ctx, elasticbeanstalkEnvironmentsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	environments, _ := v.([]elasticbeanstalktypes.EnvironmentDescription)
+	return environments, nil
+}
+
+func eksClustersListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "eks.clusters", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input eks.ListClustersInput
+		for {
+			output, err := c.Services().Eks.ListClusters(ctx, &input, func(options *eks.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			clusters := make([]*ekstypes.Cluster, 0, len(output.Clusters))
+			for _, name := range output.Clusters {
+				cluster, err := c.Services().Eks.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: aws.String(name)}, func(options *eks.Options) {
+					options.Region = c.Region
+				})
+				if err != nil {
+					return err
+				}
+				clusters = append(clusters, cluster.Cluster)
+			}
+			if err := page(clusters); err != nil {
+				return err
+			}
+			if output.NextToken == nil {
+				return nil
+			}
+			input.NextToken = output.NextToken
+		}
+	}}
+}
+
+func listEksClusters(ctx context.Context, c *client.Client) ([]*ekstypes.Cluster, error) {
+	v, err := c.List(ctx, eksClustersListing(c))
+	if err != nil {
+		return nil, err
+	}
+	clusters, _ := v.([]*ekstypes.Cluster)
+	return clusters, nil
+}
+
+func elbv2LoadBalancersListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "elbv2.load_balancers", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input elbv2.DescribeLoadBalancersInput
+		for {
+			output, err := c.Services().ELBv2.DescribeLoadBalancers(ctx, &input, func(options *elbv2.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.LoadBalancers); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextMarker) == "" {
+				return nil
+			}
+			input.Marker = output.NextMarker
+		}
+	}}
+}
+
+func listElbv2LoadBalancers(ctx context.Context, c *client.Client) ([]elbv2types.LoadBalancer, error) {
+	v, err := c.List(ctx, elbv2LoadBalancersListing(c))
+	if err != nil {
+		return nil, err
+	}
+	loadBalancers, _ := v.([]elbv2types.LoadBalancer)
+	return loadBalancers, nil
+}
+
+func lambdaFunctionsListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "lambda.functions", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input lambda.ListFunctionsInput
+		for {
+			output, err := c.Services().Lambda.ListFunctions(ctx, &input, func(options *lambda.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Functions); err != nil {
+				return err
+			}
+			if aws.ToString(output.NextMarker) == "" {
+				return nil
+			}
+			input.Marker = output.NextMarker
+		}
+	}}
+}
+
+func listLambdaFunctions(ctx context.Context, c *client.Client) ([]lambdatypes.FunctionConfiguration, error) {
+	v, err := c.List(ctx, lambdaFunctionsListing(c))
+	if err != nil {
+		return nil, err
+	}
+	functions, _ := v.([]lambdatypes.FunctionConfiguration)
+	return functions, nil
+}
+
+func rdsInstancesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "rds.instances", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input rds.DescribeDBInstancesInput
+		for {
+			output, err := c.Services().RDS.DescribeDBInstances(ctx, &input, func(options *rds.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.DBInstances); err != nil {
+				return err
+			}
+			if aws.ToString(output.Marker) == "" {
+				return nil
+			}
+			input.Marker = output.Marker
+		}
+	}}
+}
+
+func listRdsInstances(ctx context.Context, c *client.Client) ([]rdstypes.DBInstance, error) {
+	v, err := c.List(ctx, rdsInstancesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	instances, _ := v.([]rdstypes.DBInstance)
+	return instances, nil
+}
+
+func rdsClustersListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "rds.clusters", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input rds.DescribeDBClustersInput
+		for {
+			output, err := c.Services().RDS.DescribeDBClusters(ctx, &input, func(options *rds.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.DBClusters); err != nil {
+				return err
+			}
+			if aws.ToString(output.Marker) == "" {
+				return nil
+			}
+			input.Marker = output.Marker
+		}
+	}}
+}
+
+func listRdsClusters(ctx context.Context, c *client.Client) ([]rdstypes.DBCluster, error) {
+	v, err := c.List(ctx, rdsClustersListing(c))
+	if err != nil {
+		return nil, err
+	}
+	clusters, _ := v.([]rdstypes.DBCluster)
+	return clusters, nil
+}
+
+func redshiftClustersListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "redshift.clusters", Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input redshift.DescribeClustersInput
+		for {
+			output, err := c.Services().Redshift.DescribeClusters(ctx, &input, func(options *redshift.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return err
+			}
+			if err := page(output.Clusters); err != nil {
+				return err
+			}
+			if aws.ToString(output.Marker) == "" {
+				return nil
+			}
+			input.Marker = output.Marker
+		}
+	}}
+}
+
+func listRedshiftClusters(ctx context.Context, c *client.Client) ([]redshifttypes.Cluster, error) {
+	v, err := c.List(ctx, redshiftClustersListing(c))
+	if err != nil {
+		return nil, err
+	}
+	clusters, _ := v.([]redshifttypes.Cluster)
+	return clusters, nil
+}
+
+func listS3Buckets(ctx context.Context, c *client.Client) ([]s3types.Bucket, error) {
+	v, err := c.SharedAccountList(ctx, "s3.buckets", func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().S3.ListBuckets(ctx, &s3.ListBucketsInput{})
+		if err != nil {
+			return nil, err
+		}
+		return output.Buckets, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]s3types.Bucket), nil
+}
+
+// getS3BucketRegion returns the region of the bucket, us-east-1 buckets have an empty location constraint.
+func getS3BucketRegion(ctx context.Context, c *client.Client, bucket string) (string, error) {
+	v, err := c.SharedAccountList(ctx, "s3.bucket_region/"+bucket, func(ctx context.Context) (interface{}, error) {
+		region, err := c.Services().S3Manager.GetBucketRegion(ctx, bucket)
+		if err != nil {
+			return nil, err
+		}
+		if region == "" {
+			// This is a weird corner case by AWS API https://github.com/aws/aws-sdk-net/issues/323#issuecomment-196584538
+			region = "us-east-1"
+		}
+		return region, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+func getS3PublicAccessBlock(ctx context.Context, c *client.Client, bucket, region string) (*s3types.PublicAccessBlockConfiguration, error) {
+	v, err := c.SharedAccountList(ctx, "s3.public_access_block/"+bucket, func(ctx context.Context) (interface{}, error) {
+		output, err := c.Services().S3.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: aws.String(bucket)}, func(options *s3.Options) {
+			options.Region = region
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.PublicAccessBlockConfiguration, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*s3types.PublicAccessBlockConfiguration), nil
+}
+
+func listCredentialReport(ctx context.Context, c *client.Client) (reportUsers, error) {
+	v, err := c.SharedAccountList(ctx, "iam.credential_report", func(ctx context.Context) (interface{}, error) {
+		return getCredentialReport(ctx, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(reportUsers), nil
+}
+
+// getIamPasswordPolicy returns the password policy of the account, or the NoSuchEntity error of accounts without one.
+func getIamPasswordPolicy(ctx context.Context, c *client.Client) (*iam.GetAccountPasswordPolicyOutput, error) {
+	v, err := c.SharedAccountList(ctx, "iam.password_policy", func(ctx context.Context) (interface{}, error) {
+		return c.Services().IAM.GetAccountPasswordPolicy(ctx, &iam.GetAccountPasswordPolicyInput{})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*iam.GetAccountPasswordPolicyOutput), nil
+}
+
+func iamRolesListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "iam.roles", Account: true, Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input iam.ListRolesInput
+		for {
+			output, err := c.Services().IAM.ListRoles(ctx, &input)
+			if err != nil {
+				return err
+			}
+			if err := page(output.Roles); err != nil {
+				return err
+			}
+			if aws.ToString(output.Marker) == "" {
+				return nil
+			}
+			input.Marker = output.Marker
+		}
+	}}
+}
+
+func listIamRoles(ctx context.Context, c *client.Client) ([]iamtypes.Role, error) {
+	v, err := c.List(ctx, iamRolesListing(c))
+	if err != nil {
+		return nil, err
+	}
+	roles, _ := v.([]iamtypes.Role)
+	return roles, nil
+}
+
+func iamUsersListing(c *client.Client) client.Listing {
+	return client.Listing{Key: "iam.users", Account: true, Pages: func(ctx context.Context, page func(items interface{}) error) error {
+		var input iam.ListUsersInput
+		for {
+			output, err := c.Services().IAM.ListUsers(ctx, &input)
+			if err != nil {
+				return err
+			}
+			if err := page(output.Users); err != nil {
+				return err
+			}
+			if aws.ToString(output.Marker) == "" {
+				return nil
+			}
+			input.Marker = output.Marker
+		}
+	}}
+}
+
+func listIamUsers(ctx context.Context, c *client.Client) ([]iamtypes.User, error) {
+	v, err := c.List(ctx, iamUsersListing(c))
+	if err != nil {
+		return nil, err
+	}
+	users, _ := v.([]iamtypes.User)
+	return users, nil
+}
@@ -0,0 +1,340 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const schemaPackage = "github.com/cloudquery/cq-provider-sdk/provider/schema"
+
+// ValidatePathResolvers type checks the resources package in dir and verifies the path of every schema.PathResolver
+// column exists on the sdk type the table resolver sends to the resource channel. Tables whose item type can't be
+// inferred statically, i.e when interface{} values are sent, are skipped.
+func ValidatePathResolvers(dir string) ([]error, error) {
+	p, err := loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, f := range p.files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok || !p.isSchemaType(lit, "Table") {
+				return true
+			}
+			errs = append(errs, p.validateTable(lit)...)
+			return true
+		})
+	}
+	return errs, nil
+}
+
+type resourcesPackage struct {
+	fset  *token.FileSet
+	files []*ast.File
+	info  *types.Info
+	// funcs maps functions declared in the package to their declaration, so resolvers and their helpers can be read
+	funcs map[types.Object]*ast.FuncDecl
+}
+
+// loadPackage parses the package in dir and type checks it against the export data of its dependencies, which is
+// much faster than type checking the aws sdk from source.
+func loadPackage(dir string) (*resourcesPackage, error) {
+	out, err := goList(dir, "-f", "{{.Dir}}\t{{.ImportPath}}\t{{join .GoFiles \" \"}}", ".")
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Split(strings.TrimSpace(string(out)), "\t")
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected go list output %q", out)
+	}
+	pkgDir, importPath, goFiles := fields[0], fields[1], strings.Fields(fields[2])
+
+	out, err = goList(dir, "-deps", "-export", "-f", "{{.ImportPath}}\t{{.Export}}", ".")
+	if err != nil {
+		return nil, err
+	}
+	exports := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if parts := strings.SplitN(scanner.Text(), "\t", 2); len(parts) == 2 && parts[1] != "" {
+			exports[parts[0]] = parts[1]
+		}
+	}
+
+	p := &resourcesPackage{
+		fset: token.NewFileSet(),
+		info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+		funcs: make(map[types.Object]*ast.FuncDecl),
+	}
+	for _, name := range goFiles {
+		f, err := parser.ParseFile(p.fset, filepath.Join(pkgDir, name), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		p.files = append(p.files, f)
+	}
+	lookup := func(path string) (io.ReadCloser, error) {
+		export, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(export)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(p.fset, "gc", lookup)}
+	if _, err := conf.Check(importPath, p.fset, p.files, p.info); err != nil {
+		return nil, err
+	}
+	for _, f := range p.files {
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+				p.funcs[p.info.Defs[fn.Name]] = fn
+			}
+		}
+	}
+	return p, nil
+}
+
+func goList(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("go", append([]string{"list"}, args...)...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, stderr.String())
+	}
+	return out, nil
+}
+
+// isSchemaType reports whether the composite literal is a schema type, including literals with an elided type
+// such as the tables of a []*schema.Table
+func (p *resourcesPackage) isSchemaType(lit *ast.CompositeLit, name string) bool {
+	t := p.info.TypeOf(lit)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Name() == name && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == schemaPackage
+}
+
+func (p *resourcesPackage) validateTable(lit *ast.CompositeLit) []error {
+	var (
+		name     string
+		resolver ast.Expr
+		columns  *ast.CompositeLit
+	)
+	for key, value := range keyValues(lit) {
+		switch key {
+		case "Name":
+			name = stringValue(value)
+		case "Resolver":
+			resolver = value
+		case "Columns":
+			columns, _ = value.(*ast.CompositeLit)
+		}
+	}
+	if columns == nil {
+		return nil
+	}
+	item := p.itemType(resolver)
+	if item == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, elt := range columns.Elts {
+		column, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		fields := keyValues(column)
+		path, ok := pathResolverArg(p.info, fields["Resolver"])
+		if !ok {
+			continue
+		}
+		if err := validatePath(item, path); err != nil {
+			errs = append(errs, fmt.Errorf("%s: column %s: %w", name, stringValue(fields["Name"]), err))
+		}
+	}
+	return errs
+}
+
+func keyValues(lit *ast.CompositeLit) map[string]ast.Expr {
+	values := make(map[string]ast.Expr)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok {
+			values[key.Name] = kv.Value
+		}
+	}
+	return values
+}
+
+func stringValue(e ast.Expr) string {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// pathResolverArg returns the path of a schema.PathResolver("...") call
+func pathResolverArg(info *types.Info, e ast.Expr) (string, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "PathResolver" {
+		return "", false
+	}
+	fn, ok := info.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != schemaPackage {
+		return "", false
+	}
+	path := stringValue(call.Args[0])
+	return path, path != ""
+}
+
+// itemType infers the type of the items a table resolver sends, following helpers the resource channel is passed
+// to. It returns nil when the resolver sends values of different or unknown types.
+func (p *resourcesPackage) itemType(resolver ast.Expr) types.Type {
+	ident, ok := resolver.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	fn, ok := p.funcs[p.info.Uses[ident]]
+	if !ok {
+		return nil
+	}
+	// the resource channel is the last parameter of a table resolver
+	params := p.info.Defs[fn.Name].Type().(*types.Signature).Params()
+	var found []types.Type
+	p.collectSends(fn, params.Len()-1, make(map[*ast.FuncDecl]bool), &found)
+	if len(found) == 0 {
+		return nil
+	}
+	for _, t := range found[1:] {
+		if !types.Identical(t, found[0]) {
+			return nil
+		}
+	}
+	return found[0]
+}
+
+// collectSends appends the item types sent to the channel parameter at index param of fn
+func (p *resourcesPackage) collectSends(fn *ast.FuncDecl, param int, visited map[*ast.FuncDecl]bool, found *[]types.Type) {
+	if visited[fn] || fn.Body == nil {
+		return
+	}
+	visited[fn] = true
+	ch := paramObject(p.info, fn, param)
+	if ch == nil {
+		return
+	}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SendStmt:
+			if id, ok := n.Chan.(*ast.Ident); ok && p.info.Uses[id] == ch {
+				*found = append(*found, itemOf(p.info.TypeOf(n.Value)))
+			}
+		case *ast.CallExpr:
+			callee, ok := n.Fun.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			helper, ok := p.funcs[p.info.Uses[callee]]
+			if !ok {
+				return true
+			}
+			for i, arg := range n.Args {
+				if id, ok := arg.(*ast.Ident); ok && p.info.Uses[id] == ch {
+					p.collectSends(helper, i, visited, found)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// paramObject returns the object of the parameter at index i, counting each name of grouped parameters
+func paramObject(info *types.Info, fn *ast.FuncDecl, i int) types.Object {
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			if i == 0 {
+				return info.Defs[name]
+			}
+			i--
+		}
+	}
+	return nil
+}
+
+// itemOf returns the type of a single resource, as resolvers may send slices of resources
+func itemOf(t types.Type) types.Type {
+	if s, ok := t.Underlying().(*types.Slice); ok {
+		t = s.Elem()
+	}
+	return t
+}
+
+// validatePath walks the dot separated path through the fields of t the way funk.Get does, descending into the
+// elements of slices. Maps and interfaces can hold any key, so the rest of the path isn't checked.
+func validatePath(t types.Type, path string) error {
+	current := t
+	for _, part := range strings.Split(path, ".") {
+		for {
+			switch u := current.Underlying().(type) {
+			case *types.Pointer:
+				current = u.Elem()
+				continue
+			case *types.Slice:
+				current = u.Elem()
+				continue
+			case *types.Array:
+				current = u.Elem()
+				continue
+			}
+			break
+		}
+		switch current.Underlying().(type) {
+		case *types.Map, *types.Interface:
+			return nil
+		case *types.Struct:
+			// promoted fields of embedded structs are found too, like reflect's FieldByName
+			obj, _, _ := types.LookupFieldOrMethod(current, false, nil, part)
+			field, ok := obj.(*types.Var)
+			if !ok {
+				return fmt.Errorf("path %s doesn't exist on %s, %s has no field %s", path, t, current, part)
+			}
+			current = field.Type()
+		default:
+			return fmt.Errorf("path %s doesn't exist on %s, %s isn't a struct", path, t, current)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+// Package validation checks that the tables of the provider follow the conventions of the repository, so drift
+// such as duplicate table names or delete filters that don't match the multiplexer is caught by the tests.
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	probeAccountID = "123456789012"
+	probeRegion    = "us-east-1"
+)
+
+// servicePrefixes holds the table name prefix of services whose resource map key differs from it
+var servicePrefixes = map[string]string{
+	"accessanalyzer": "access_analyzer",
+	"secrets":        "secret",
+}
+
+// globalServices aren't regional, their tables must be fetched once per account with client.GlobalMultiplex
+var globalServices = map[string]bool{
+	"cloudfront":    true,
+	"iam":           true,
+	"organizations": true,
+	"route53":       true,
+}
+
+// multiplexKind is the scope a table is fetched in, which decides its required columns and delete filter
+type multiplexKind int
+
+const (
+	multiplexNone multiplexKind = iota
+	multiplexAccount
+	multiplexAccountRegion
+	multiplexGlobal
+	multiplexRegion
+	multiplexUnknown
+)
+
+func (k multiplexKind) String() string {
+	switch k {
+	case multiplexAccount:
+		return "AccountMultiplex"
+	case multiplexAccountRegion:
+		return "AccountRegionMultiplex"
+	case multiplexGlobal:
+		return "GlobalMultiplex"
+	case multiplexRegion:
+		return "RegionMultiplex"
+	case multiplexNone:
+		return "no multiplexer"
+	}
+	return "unknown multiplexer"
+}
+
+func sameFunc(a, b interface{}) bool {
+	return reflect.ValueOf(a).Pointer() == reflect.ValueOf(b).Pointer()
+}
+
+func tableMultiplex(t *schema.Table) multiplexKind {
+	switch {
+	case t.Multiplex == nil:
+		return multiplexNone
+	case sameFunc(t.Multiplex, client.AccountMultiplex):
+		return multiplexAccount
+	case sameFunc(t.Multiplex, client.AccountRegionMultiplex):
+		return multiplexAccountRegion
+	case sameFunc(t.Multiplex, client.GlobalMultiplex):
+		return multiplexGlobal
+	case sameFunc(t.Multiplex, client.RegionMultiplex):
+		return multiplexRegion
+	}
+	return multiplexUnknown
+}
+
+// ValidateTables checks the tables of a provider resource map and returns every violation found
+func ValidateTables(resourceMap map[string]*schema.Table) []error {
+	var errs []error
+	names := make(map[string]string)
+	for resource, t := range resourceMap {
+		service := strings.SplitN(resource, ".", 2)[0]
+		errs = append(errs, validateGlobal(resource, service, t)...)
+		if p, ok := servicePrefixes[service]; ok {
+			service = p
+		}
+		prefix := "aws_" + service + "_"
+		walkTables(t, func(table *schema.Table) {
+			if other, ok := names[table.Name]; ok {
+				errs = append(errs, fmt.Errorf("%s: table name %s is also used by %s", resource, table.Name, other))
+			}
+			names[table.Name] = resource
+			if !strings.HasPrefix(table.Name, prefix) {
+				errs = append(errs, fmt.Errorf("%s: table name %s doesn't start with %s", resource, table.Name, prefix))
+			}
+			errs = append(errs, validateColumnNames(resource, table)...)
+		})
+		errs = append(errs, validateMultiplex(resource, t)...)
+	}
+	return errs
+}
+
+// validateGlobal checks tables of global services use client.GlobalMultiplex, as fetching them per region
+// duplicates every resource, and that only they do
+func validateGlobal(resource, service string, t *schema.Table) []error {
+	kind := tableMultiplex(t)
+	switch {
+	case globalServices[service] && kind != multiplexGlobal:
+		return []error{fmt.Errorf("%s: %s is a global service, table %s must use GlobalMultiplex instead of %s", resource, service, t.Name, kind)}
+	case !globalServices[service] && kind == multiplexGlobal:
+		return []error{fmt.Errorf("%s: table %s uses GlobalMultiplex but %s isn't a global service", resource, t.Name, service)}
+	}
+	return nil
+}
+
+func walkTables(t *schema.Table, f func(*schema.Table)) {
+	f(t)
+	for _, rel := range t.Relations {
+		walkTables(rel, f)
+	}
+}
+
+func validateColumnNames(resource string, t *schema.Table) []error {
+	var errs []error
+	seen := make(map[string]bool)
+	for _, c := range t.Columns {
+		if seen[c.Name] {
+			errs = append(errs, fmt.Errorf("%s: column %s of %s is defined twice", resource, c.Name, t.Name))
+		}
+		seen[c.Name] = true
+	}
+	return errs
+}
+
+// validateMultiplex checks the account_id and region columns and the delete filter of a top level table
+// against its multiplexer
+func validateMultiplex(resource string, t *schema.Table) []error {
+	kind := tableMultiplex(t)
+	switch kind {
+	case multiplexNone, multiplexUnknown:
+		return []error{fmt.Errorf("%s: table %s has %s, expected AccountMultiplex, AccountRegionMultiplex, GlobalMultiplex or RegionMultiplex", resource, t.Name, kind)}
+	}
+
+	var errs []error
+	account, region := findColumn(t, client.ResolveAWSAccount), findColumn(t, client.ResolveAWSRegion)
+	switch {
+	case kind == multiplexRegion && account != nil:
+		// the account of region clients is an arbitrary one of the accounts fetching the region
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but resolves column %s by client.ResolveAWSAccount", resource, t.Name, kind, account.Name))
+	case kind != multiplexRegion && (account == nil || account.Name != "account_id"):
+		errs = append(errs, fmt.Errorf("%s: table %s must have an account_id column resolved by client.ResolveAWSAccount", resource, t.Name))
+	}
+	switch {
+	case (kind == multiplexAccountRegion || kind == multiplexGlobal || kind == multiplexRegion) && (region == nil || region.Name != "region"):
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s and must have a region column resolved by client.ResolveAWSRegion", resource, t.Name, kind))
+	case kind == multiplexAccount && region != nil:
+		// account clients aren't bound to a region, tables of global services use GlobalMultiplex to get one
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but resolves column %s by client.ResolveAWSRegion", resource, t.Name, kind, region.Name))
+	}
+	for _, rel := range t.Relations {
+		walkTables(rel, func(r *schema.Table) {
+			if r.Multiplex != nil || r.DeleteFilter != nil {
+				errs = append(errs, fmt.Errorf("%s: relation %s must not set a multiplexer or delete filter", resource, r.Name))
+			}
+		})
+	}
+	return append(errs, validateDeleteFilter(resource, t, kind)...)
+}
+
+func findColumn(t *schema.Table, resolver schema.ColumnResolver) *schema.Column {
+	for i, c := range t.Columns {
+		if c.Resolver != nil && sameFunc(c.Resolver, resolver) {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+// validateDeleteFilter calls the delete filter with a probe client, as filters are often wrapped by closures
+// such as client.DeleteTargetFilter, and checks it scopes the deletion to the client's account and region, or only
+// to the region for tables using client.RegionMultiplex.
+func validateDeleteFilter(resource string, t *schema.Table, kind multiplexKind) []error {
+	if t.DeleteFilter == nil {
+		return []error{fmt.Errorf("%s: table %s uses %s but has no DeleteFilter", resource, t.Name, kind)}
+	}
+	c := client.NewAwsClient(hclog.NewNullLogger(), []string{probeRegion})
+	c.AccountID = probeAccountID
+	c.Region = probeRegion
+	if kind == multiplexGlobal {
+		c.Region = client.GlobalRegion
+	}
+	args := t.DeleteFilter(&c)
+	if len(args)%2 != 0 {
+		return []error{fmt.Errorf("%s: delete filter of %s returned an odd number of arguments", resource, t.Name)}
+	}
+
+	var errs []error
+	filters := make(map[string]interface{})
+	for i := 0; i < len(args); i += 2 {
+		column := strings.ToLower(fmt.Sprint(args[i]))
+		filters[column] = args[i+1]
+		if column != "id" && !hasColumn(t, column) {
+			errs = append(errs, fmt.Errorf("%s: delete filter of %s uses unknown column %s", resource, t.Name, column))
+		}
+	}
+	_, byAccount := filters["account_id"]
+	switch {
+	case kind == multiplexRegion && byAccount:
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but its delete filter filters by account_id, use client.DeleteRegionFilter", resource, t.Name, kind))
+	case kind != multiplexRegion && filters["account_id"] != probeAccountID:
+		errs = append(errs, fmt.Errorf("%s: delete filter of %s doesn't filter by account_id", resource, t.Name))
+	}
+	_, byRegion := filters["region"]
+	switch {
+	case kind == multiplexRegion && filters["region"] != probeRegion:
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but its delete filter doesn't filter by region, use client.DeleteRegionFilter", resource, t.Name, kind))
+	case kind == multiplexAccountRegion && filters["region"] != probeRegion:
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but its delete filter doesn't filter by region, use client.DeleteAccountRegionFilter", resource, t.Name, kind))
+	case kind == multiplexGlobal && byRegion && filters["region"] != client.GlobalRegion:
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but its delete filter filters by another region than %s", resource, t.Name, kind, client.GlobalRegion))
+	case kind == multiplexAccount && byRegion:
+		errs = append(errs, fmt.Errorf("%s: table %s uses %s but its delete filter filters by region, use client.DeleteAccountFilter", resource, t.Name, kind))
+	}
+	return errs
+}
+
+func hasColumn(t *schema.Table, name string) bool {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
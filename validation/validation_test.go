@@ -0,0 +1,45 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/resources"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func TestValidateTables(t *testing.T) {
+	for _, err := range ValidateTables(resources.Provider().ResourceMap) {
+		t.Error(err)
+	}
+}
+
+func TestValidatePathResolvers(t *testing.T) {
+	errs, err := ValidatePathResolvers("../resources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestValidateTablesDuplicateNames(t *testing.T) {
+	relation := func() *schema.Table {
+		return &schema.Table{Name: "aws_test_things_tags", Columns: []schema.Column{{Name: "key", Type: schema.TypeString}}}
+	}
+	// the relation name is repeated within the same resource tree
+	errs := ValidateTables(map[string]*schema.Table{
+		"test.things": {
+			Name:         "aws_test_things",
+			Multiplex:    client.AccountMultiplex,
+			DeleteFilter: client.DeleteAccountFilter,
+			Columns:      []schema.Column{{Name: "account_id", Type: schema.TypeString, Resolver: client.ResolveAWSAccount}},
+			Relations:    []*schema.Table{relation(), relation()},
+		},
+	})
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "table name aws_test_things_tags is also used by test.things") {
+		t.Fatalf("expected a duplicate table name error, got %v", errs)
+	}
+}